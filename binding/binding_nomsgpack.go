@@ -7,7 +7,10 @@
 
 package binding
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+)
 
 // Content-Type MIME of the most common data formats.
 const (
@@ -61,11 +64,57 @@ type StructValidator interface {
 	Engine() interface{}
 }
 
+// GroupStructValidator is implemented by StructValidators that support
+// validation groups, e.g. one set of rules for create and another for update.
+type GroupStructValidator interface {
+	StructValidator
+
+	// ValidateStructGroups behaves like ValidateStruct, but additionally applies
+	// the rules bound to any of the given validation groups.
+	ValidateStructGroups(obj interface{}, groups ...string) error
+}
+
+// ContextStructValidator is implemented by StructValidators that can stop
+// validating once the request context is done, e.g. when the client
+// disconnects.
+type ContextStructValidator interface {
+	StructValidator
+
+	// ValidateStructCtx behaves like ValidateStruct, but gives up and returns
+	// an error once ctx is canceled or its deadline exceeded.
+	ValidateStructCtx(ctx context.Context, obj interface{}) error
+}
+
+// ModifyingStructValidator is implemented by StructValidators that can sanitize
+// a struct, e.g. trim and lowercase its strings, before validating it.
+type ModifyingStructValidator interface {
+	StructValidator
+
+	// ModifyStruct runs the modifiers of obj, a pointer to a struct or a slice
+	// of them, changing it in place.
+	ModifyStruct(ctx context.Context, obj interface{}) error
+}
+
+// PrecompileValidator is implemented by StructValidators that can verify the
+// rules of structs before they are first validated.
+type PrecompileValidator interface {
+	StructValidator
+
+	// Precompile parses the rules of the given structs, and of the structs
+	// they contain, returning an error describing every invalid rule.
+	Precompile(objs ...interface{}) error
+}
+
 // Validator is the default validator which implements the StructValidator
 // interface. It uses https://github.com/go-playground/validator/tree/v8.18.2
 // under the hood.
 var Validator StructValidator = &defaultValidator{}
 
+// EnableModifiers makes bindings run the modifiers of Validator, if it
+// implements ModifyingStructValidator, between decoding a request and
+// validating it, e.g. those of the mod tags of the default Validator.
+var EnableModifiers = false
+
 // These implement the Binding interface and can be used to bind the data
 // present in the request to struct instances.
 var (
@@ -105,8 +154,47 @@ func Default(method, contentType string) Binding {
 }
 
 func validate(obj interface{}) error {
+	return validateCtx(context.Background(), obj)
+}
+
+// validateCtx validates obj with Validator, passing it ctx if it implements
+// ContextStructValidator, after running its modifiers if EnableModifiers is set.
+func validateCtx(ctx context.Context, obj interface{}) error {
+	if Validator == nil {
+		return nil
+	}
+	if mv, ok := Validator.(ModifyingStructValidator); ok && EnableModifiers {
+		if err := mv.ModifyStruct(ctx, obj); err != nil {
+			return err
+		}
+	}
+	if cv, ok := Validator.(ContextStructValidator); ok {
+		return cv.ValidateStructCtx(ctx, obj)
+	}
+	return Validator.ValidateStruct(obj)
+}
+
+// ValidateGroups validates obj with Validator applying the rules of the given
+// validation groups. Bindings only apply rules that are not bound to a group,
+// so call it after binding when a request needs the rules of a scenario.
+// If Validator does not support groups, obj is validated with ValidateStruct.
+func ValidateGroups(obj interface{}, groups ...string) error {
 	if Validator == nil {
 		return nil
 	}
+	if gv, ok := Validator.(GroupStructValidator); ok {
+		return gv.ValidateStructGroups(obj, groups...)
+	}
 	return Validator.ValidateStruct(obj)
 }
+
+// Precompile verifies the validation rules of request structs with Validator
+// ahead of the first request: the given structs and those registered with
+// RegisterRequest by the routes of a router, e.g. when it starts. It returns
+// nil if Validator does not implement PrecompileValidator.
+func Precompile(objs ...interface{}) error {
+	if pv, ok := Validator.(PrecompileValidator); ok {
+		return pv.Precompile(append(registeredRequests(), objs...)...)
+	}
+	return nil
+}
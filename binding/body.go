@@ -0,0 +1,68 @@
+package binding
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// MaxBodyBytes is the maximum number of bytes BindBodyWith reads from the
+// request body before giving up with ErrBodyTooLarge. A value <= 0 disables
+// the limit.
+var MaxBodyBytes int64 = defaultMemory
+
+// ErrBodyTooLarge is returned by BindBodyWith when the request body exceeds
+// MaxBodyBytes.
+var ErrBodyTooLarge = errors.New("request body too large")
+
+type bodyBytesKey struct{}
+
+// BodyBytes returns the request body cached by a previous BindBodyWith call.
+func BodyBytes(req *http.Request) ([]byte, bool) {
+	body, ok := req.Context().Value(bodyBytesKey{}).([]byte)
+	return body, ok
+}
+
+// BindBodyWith binds the request body into obj using bb. The body is read
+// only once and cached on the request context, so the same body can be bound
+// several times, e.g. into an envelope and a payload, or with a JSON binding
+// first and another BindingBody as a fallback.
+//
+// After the first call req.Body is replaced with a reader over the cached
+// bytes, so bindings that read req.Body directly keep working as well.
+func BindBodyWith(req *http.Request, obj interface{}, bb BindingBody) error {
+	if req == nil {
+		return errors.New("invalid request")
+	}
+	body, ok := BodyBytes(req)
+	if !ok {
+		var err error
+		if body, err = readBody(req); err != nil {
+			return err
+		}
+		*req = *req.WithContext(context.WithValue(req.Context(), bodyBytesKey{}, body))
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	return bb.BindBody(body, obj)
+}
+
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, errors.New("invalid request")
+	}
+	var r io.Reader = req.Body
+	if MaxBodyBytes > 0 {
+		r = io.LimitReader(req.Body, MaxBodyBytes+1)
+	}
+	body, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if MaxBodyBytes > 0 && int64(len(body)) > MaxBodyBytes {
+		return nil, ErrBodyTooLarge
+	}
+	return body, nil
+}
@@ -0,0 +1,60 @@
+package binding
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindBodyWithMultipleTimes(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"foo": "FOO", "bar": "BAR"}`))
+
+	var envelope FooStruct
+	require.NoError(t, BindBodyWith(req, &envelope, JSON))
+	assert.Equal(t, "FOO", envelope.Foo)
+
+	var payload FooBarStruct
+	require.NoError(t, BindBodyWith(req, &payload, JSON))
+	assert.Equal(t, "FOO", payload.Foo)
+	assert.Equal(t, "BAR", payload.Bar)
+
+	body, ok := BodyBytes(req)
+	assert.True(t, ok)
+	assert.Equal(t, `{"foo": "FOO", "bar": "BAR"}`, string(body))
+
+	rest, err := ioutil.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, body, rest)
+}
+
+func TestBindBodyWithFallback(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodPost, "/", bytes.NewBufferString("foo: FOO"))
+
+	var obj FooStruct
+	assert.Error(t, BindBodyWith(req, &obj, JSON))
+	require.NoError(t, BindBodyWith(req, &obj, YAML))
+	assert.Equal(t, "FOO", obj.Foo)
+}
+
+func TestBindBodyWithTooLarge(t *testing.T) {
+	defer func(n int64) { MaxBodyBytes = n }(MaxBodyBytes)
+	MaxBodyBytes = 8
+
+	req, _ := http.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"foo": "FOO"}`))
+	var obj FooStruct
+	assert.Equal(t, ErrBodyTooLarge, BindBodyWith(req, &obj, JSON))
+	_, ok := BodyBytes(req)
+	assert.False(t, ok)
+}
+
+func TestBindBodyWithInvalidRequest(t *testing.T) {
+	var obj FooStruct
+	assert.Error(t, BindBodyWith(nil, &obj, JSON))
+
+	req, _ := http.NewRequest(http.MethodPost, "/", nil)
+	assert.Error(t, BindBodyWith(req, &obj, JSON))
+}
@@ -5,6 +5,8 @@
 package binding
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -31,10 +33,29 @@ func (err sliceValidateError) Error() string {
 	return strings.Join(errMsgs, "\n")
 }
 
-var _ StructValidator = &defaultValidator{}
+var _ GroupStructValidator = &defaultValidator{}
+var _ PrecompileValidator = &defaultValidator{}
+var _ ContextStructValidator = &defaultValidator{}
+var _ ModifyingStructValidator = &defaultValidator{}
 
 // ValidateStruct receives any kind of type, but only performed struct or pointer to struct type.
 func (v *defaultValidator) ValidateStruct(obj interface{}) error {
+	return v.validateGroups(context.Background(), obj, nil)
+}
+
+// ValidateStructCtx is like ValidateStruct, but stops once ctx is done and
+// returns a *validator.CanceledError.
+func (v *defaultValidator) ValidateStructCtx(ctx context.Context, obj interface{}) error {
+	return v.validateGroups(ctx, obj, nil)
+}
+
+// ValidateStructGroups is like ValidateStruct, but additionally applies the
+// rules bound to the given validation groups.
+func (v *defaultValidator) ValidateStructGroups(obj interface{}, groups ...string) error {
+	return v.validateGroups(context.Background(), obj, groups)
+}
+
+func (v *defaultValidator) validateGroups(ctx context.Context, obj interface{}, groups []string) error {
 	if obj == nil {
 		return nil
 	}
@@ -42,16 +63,23 @@ func (v *defaultValidator) ValidateStruct(obj interface{}) error {
 	value := reflect.ValueOf(obj)
 	switch value.Kind() {
 	case reflect.Ptr:
-		return v.ValidateStruct(value.Elem().Interface())
+		return v.validateGroups(ctx, value.Elem().Interface(), groups)
 	case reflect.Struct:
-		return v.validateStruct(obj)
+		return v.validateStruct(ctx, obj, groups)
 	case reflect.Slice, reflect.Array:
 		count := value.Len()
 		validateRet := make(sliceValidateError, 0)
 		for i := 0; i < count; i++ {
-			if err := v.ValidateStruct(value.Index(i).Interface()); err != nil {
-				validateRet = append(validateRet, err)
+			err := v.validateGroups(ctx, value.Index(i).Interface(), groups)
+			if err == nil {
+				continue
 			}
+			// no point validating the remaining elements
+			var ce *validator.CanceledError
+			if errors.As(err, &ce) {
+				return err
+			}
+			validateRet = append(validateRet, err)
 		}
 		if len(validateRet) == 0 {
 			return nil
@@ -63,9 +91,58 @@ func (v *defaultValidator) ValidateStruct(obj interface{}) error {
 }
 
 // validateStruct receives struct type
-func (v *defaultValidator) validateStruct(obj interface{}) error {
+func (v *defaultValidator) validateStruct(ctx context.Context, obj interface{}, groups []string) error {
+	v.lazyinit()
+	if len(groups) > 0 {
+		return v.validate.StructGroups(ctx, obj, groups...)
+	}
+	return v.validate.StructCtx(ctx, obj)
+}
+
+// ModifyStruct runs the modifiers of the mod tags of obj, a pointer to a struct
+// or a slice or array of structs, see validator.Validate.Modify. Other values
+// are left unchanged.
+func (v *defaultValidator) ModifyStruct(ctx context.Context, obj interface{}) error {
+	if obj == nil {
+		return nil
+	}
+	return v.modify(ctx, reflect.ValueOf(obj))
+}
+
+func (v *defaultValidator) modify(ctx context.Context, value reflect.Value) error {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		if value.Kind() == reflect.Ptr && value.Elem().Kind() == reflect.Struct {
+			v.lazyinit()
+			return v.validate.ModifyCtx(ctx, value.Interface())
+		}
+		return v.modify(ctx, value.Elem())
+	case reflect.Struct:
+		// only addressable structs can be modified
+		if value.CanAddr() {
+			return v.modify(ctx, value.Addr())
+		}
+		return nil
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if err := v.modify(ctx, value.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return nil
+	}
+}
+
+// Precompile parses and caches the rules of the given structs, see
+// validator.Validate.Precompile.
+func (v *defaultValidator) Precompile(objs ...interface{}) error {
 	v.lazyinit()
-	return v.validate.Struct(obj)
+	return v.validate.Precompile(objs...)
 }
 
 // Engine returns the underlying validator engine which powers the default
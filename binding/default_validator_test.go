@@ -5,8 +5,11 @@
 package binding
 
 import (
+	"context"
 	"errors"
 	"testing"
+
+	"frames/validator"
 )
 
 func TestSliceValidateError(t *testing.T) {
@@ -66,3 +69,119 @@ func TestDefaultValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultValidatorGroups(t *testing.T) {
+	type exampleStruct struct {
+		ID   int    `binding:"required" groups:"update"`
+		Name string `binding:"create:required;update:omitempty,min=3"`
+	}
+	tests := []struct {
+		name    string
+		obj     interface{}
+		groups  []string
+		wantErr bool
+	}{
+		{"validate without groups", exampleStruct{}, nil, false},
+		{"validate create failed", exampleStruct{}, []string{"create"}, true},
+		{"validate create passed", exampleStruct{Name: "a"}, []string{"create"}, false},
+		{"validate update failed", &exampleStruct{ID: 1, Name: "ab"}, []string{"update"}, true},
+		{"validate update passed", &exampleStruct{ID: 1}, []string{"update"}, false},
+		{"validate []struct update failed", []exampleStruct{{ID: 1}, {}}, []string{"update"}, true},
+	}
+	v := &defaultValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := v.ValidateStructGroups(tt.obj, tt.groups...); (err != nil) != tt.wantErr {
+				t.Errorf("defaultValidator.ValidateStructGroups() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDefaultValidatorPrecompile(t *testing.T) {
+	type itemStruct struct {
+		Name string `binding:"required,mni=1"`
+	}
+	type validStruct struct {
+		Name string `binding:"required"`
+	}
+	type requestStruct struct {
+		Items []itemStruct `binding:"required,dive"`
+		Valid *validStruct
+	}
+
+	v := &defaultValidator{}
+	if err := v.Precompile(validStruct{}); err != nil {
+		t.Errorf("defaultValidator.Precompile() error = %v, want nil", err)
+	}
+
+	err := v.Precompile(&requestStruct{})
+	errs, ok := err.(validator.PrecompileErrors)
+	if !ok || len(errs) != 1 {
+		t.Fatalf("defaultValidator.Precompile() error = %v, want one error", err)
+	}
+	if fte := errs[0].(*validator.FieldTagError); fte.Field != "Name" || fte.Tag != "required,mni=1" {
+		t.Errorf("defaultValidator.Precompile() error = %v", fte)
+	}
+}
+
+func TestDefaultValidatorCtx(t *testing.T) {
+	type itemStruct struct {
+		Name string `binding:"required"`
+	}
+
+	v := &defaultValidator{}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if err := v.ValidateStructCtx(ctx, &itemStruct{Name: "name"}); err != nil {
+		t.Errorf("defaultValidator.ValidateStructCtx() error = %v, want nil", err)
+	}
+
+	cancel()
+	err := v.ValidateStructCtx(ctx, []itemStruct{{}, {}})
+	var ce *validator.CanceledError
+	if !errors.As(err, &ce) || !errors.Is(err, context.Canceled) {
+		t.Errorf("defaultValidator.ValidateStructCtx() error = %v, want a *validator.CanceledError", err)
+	}
+}
+
+func TestDefaultValidatorModifyStruct(t *testing.T) {
+	type exampleStruct struct {
+		A string `mod:"trim,lower"`
+	}
+
+	v := &defaultValidator{}
+
+	obj := &exampleStruct{A: " ABC "}
+	if err := v.ModifyStruct(context.Background(), obj); err != nil {
+		t.Fatal(err)
+	}
+	if obj.A != "abc" {
+		t.Errorf("A = %q, want %q", obj.A, "abc")
+	}
+
+	objs := &[]exampleStruct{{A: " X"}, {A: "Y "}}
+	if err := v.ModifyStruct(context.Background(), objs); err != nil {
+		t.Fatal(err)
+	}
+	if (*objs)[0].A != "x" || (*objs)[1].A != "y" {
+		t.Errorf("objs = %v, want [{x} {y}]", *objs)
+	}
+
+	ptrs := []*exampleStruct{{A: " Z"}, nil}
+	if err := v.ModifyStruct(context.Background(), ptrs); err != nil {
+		t.Fatal(err)
+	}
+	if ptrs[0].A != "z" {
+		t.Errorf("A = %q, want %q", ptrs[0].A, "z")
+	}
+
+	// values which cannot be modified in place are left unchanged
+	value := exampleStruct{A: " ABC "}
+	if err := v.ModifyStruct(context.Background(), value); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.ModifyStruct(context.Background(), 3); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -30,7 +30,7 @@ func (formBinding) Bind(req *http.Request, obj interface{}) error {
 	if err := mapForm(obj, req.Form); err != nil {
 		return err
 	}
-	return validate(obj)
+	return validateCtx(req.Context(), obj)
 }
 
 func (formPostBinding) Name() string {
@@ -44,7 +44,7 @@ func (formPostBinding) Bind(req *http.Request, obj interface{}) error {
 	if err := mapForm(obj, req.PostForm); err != nil {
 		return err
 	}
-	return validate(obj)
+	return validateCtx(req.Context(), obj)
 }
 
 func (formMultipartBinding) Name() string {
@@ -59,5 +59,5 @@ func (formMultipartBinding) Bind(req *http.Request, obj interface{}) error {
 		return err
 	}
 
-	return validate(obj)
+	return validateCtx(req.Context(), obj)
 }
@@ -14,10 +14,23 @@ import (
 
 	"frames/internal/bytesconv"
 	"frames/internal/json"
+	"frames/validator"
 )
 
 var errUnknownType = errors.New("unknown type")
 
+// MaxFormMapKeys limits the number of keys a map field accepts from
+// prefixed form keys such as labels[env]=prod. A value <= 0 disables the limit.
+var MaxFormMapKeys = 256
+
+// ParamUnmarshaler is the interface implemented by types that can unmarshal
+// themselves from the raw values of a query, form, header or uri parameter.
+// It takes precedence over the built-in conversions; for slices and arrays of
+// such types UnmarshalParam is called once per element with a single value.
+type ParamUnmarshaler interface {
+	UnmarshalParam(values []string) error
+}
+
 func mapUri(ptr interface{}, m map[string][]string) error {
 	return mapFormByTag(ptr, m, "uri")
 }
@@ -63,6 +76,12 @@ func (form formSource) TrySet(value reflect.Value, field reflect.StructField, ta
 
 func mappingByPtr(ptr interface{}, setter setter, tag string) error {
 	_, err := mapping(reflect.ValueOf(ptr), emptyField, setter, tag)
+	if te, ok := err.(*typeError); ok {
+		if name := reflect.Indirect(reflect.ValueOf(ptr)).Type().Name(); name != "" {
+			te.push(name, name)
+		}
+		return validator.ValidationErrors{te.fieldError()}
+	}
 	return err
 }
 
@@ -109,9 +128,13 @@ func mapping(value reflect.Value, field reflect.StructField, setter setter, tag
 			if sf.PkgPath != "" && !sf.Anonymous { // unexported
 				continue
 			}
-			ok, err := mapping(value.Field(i), tValue.Field(i), setter, tag)
+			ok, err := mapping(value.Field(i), sf, setter, tag)
 			if err != nil {
-				return false, err
+				name, _ := head(sf.Tag.Get(tag), ",")
+				if name == "" {
+					name = sf.Name
+				}
+				return false, pushTypeError(err, name, sf.Name)
 			}
 			isSetted = isSetted || ok
 		}
@@ -154,10 +177,22 @@ func tryToSetValue(value reflect.Value, field reflect.StructField, setter setter
 
 func setByForm(value reflect.Value, field reflect.StructField, form map[string][]string, tagValue string, opt setOptions) (isSetted bool, err error) {
 	vs, ok := form[tagValue]
+	if !ok && value.Kind() == reflect.Map {
+		if isSetted, err = setFormMapField(value, field, form, tagValue); isSetted || err != nil {
+			return isSetted, err
+		}
+	}
 	if !ok && !opt.isDefaultExists {
 		return false, nil
 	}
 
+	if u, isUnmarshaler := paramUnmarshaler(value); isUnmarshaler {
+		if !ok {
+			vs = []string{opt.defaultValue}
+		}
+		return true, u.UnmarshalParam(vs)
+	}
+
 	switch value.Kind() {
 	case reflect.Slice:
 		if !ok {
@@ -185,6 +220,45 @@ func setByForm(value reflect.Value, field reflect.StructField, form map[string][
 	}
 }
 
+// setFormMapField fills a map field keyed by strings from form keys of the
+// form name[key], converting every value with the same rules as struct fields.
+func setFormMapField(value reflect.Value, field reflect.StructField, form map[string][]string, name string) (bool, error) {
+	mapType := value.Type()
+	if mapType.Key().Kind() != reflect.String {
+		return false, nil
+	}
+
+	prefix := name + "["
+	var m reflect.Value
+	for k := range form {
+		if !strings.HasPrefix(k, prefix) || !strings.HasSuffix(k, "]") {
+			continue
+		}
+		key := k[len(prefix) : len(k)-1]
+		if strings.ContainsAny(key, "[]") {
+			continue
+		}
+		if !m.IsValid() {
+			m = reflect.MakeMap(mapType)
+		}
+		if MaxFormMapKeys > 0 && m.Len() >= MaxFormMapKeys {
+			return false, fmt.Errorf("%s: too many map keys (max %d)", name, MaxFormMapKeys)
+		}
+
+		elem := reflect.New(mapType.Elem()).Elem()
+		if _, err := setByForm(elem, field, form, k, setOptions{}); err != nil {
+			return false, pushTypeError(err, "["+key+"]", "["+key+"]")
+		}
+		m.SetMapIndex(reflect.ValueOf(key).Convert(mapType.Key()), elem)
+	}
+	if !m.IsValid() {
+		return false, nil
+	}
+
+	value.Set(m)
+	return true, nil
+}
+
 func setWithProperType(val string, value reflect.Value, field reflect.StructField) error {
 	switch value.Kind() {
 	case reflect.Int:
@@ -227,6 +301,11 @@ func setWithProperType(val string, value reflect.Value, field reflect.StructFiel
 		return json.Unmarshal(bytesconv.StringToBytes(val), value.Addr().Interface())
 	case reflect.Map:
 		return json.Unmarshal(bytesconv.StringToBytes(val), value.Addr().Interface())
+	case reflect.Ptr:
+		if value.IsNil() {
+			value.Set(reflect.New(value.Type().Elem()))
+		}
+		return setWithProperType(val, value.Elem(), field)
 	default:
 		return errUnknownType
 	}
@@ -238,10 +317,11 @@ func setIntField(val string, bitSize int, field reflect.Value) error {
 		val = "0"
 	}
 	intVal, err := strconv.ParseInt(val, 10, bitSize)
-	if err == nil {
-		field.SetInt(intVal)
+	if err != nil {
+		return newTypeError(typeIntTag, val, field.Type(), err)
 	}
-	return err
+	field.SetInt(intVal)
+	return nil
 }
 
 func setUintField(val string, bitSize int, field reflect.Value) error {
@@ -249,10 +329,11 @@ func setUintField(val string, bitSize int, field reflect.Value) error {
 		val = "0"
 	}
 	uintVal, err := strconv.ParseUint(val, 10, bitSize)
-	if err == nil {
-		field.SetUint(uintVal)
+	if err != nil {
+		return newTypeError(typeUintTag, val, field.Type(), err)
 	}
-	return err
+	field.SetUint(uintVal)
+	return nil
 }
 
 func setBoolField(val string, field reflect.Value) error {
@@ -260,10 +341,11 @@ func setBoolField(val string, field reflect.Value) error {
 		val = "false"
 	}
 	boolVal, err := strconv.ParseBool(val)
-	if err == nil {
-		field.SetBool(boolVal)
+	if err != nil {
+		return newTypeError(typeBoolTag, val, field.Type(), err)
 	}
-	return err
+	field.SetBool(boolVal)
+	return nil
 }
 
 func setFloatField(val string, bitSize int, field reflect.Value) error {
@@ -271,39 +353,76 @@ func setFloatField(val string, bitSize int, field reflect.Value) error {
 		val = "0.0"
 	}
 	floatVal, err := strconv.ParseFloat(val, bitSize)
-	if err == nil {
-		field.SetFloat(floatVal)
+	if err != nil {
+		return newTypeError(typeFloatTag, val, field.Type(), err)
 	}
-	return err
+	field.SetFloat(floatVal)
+	return nil
+}
+
+// namedTimeLayouts are the layout names accepted by the time_format tag in
+// addition to Go reference layouts.
+var namedTimeLayouts = map[string]string{
+	"rfc3339":     time.RFC3339,
+	"rfc3339nano": time.RFC3339Nano,
+	"rfc1123":     time.RFC1123,
+	"rfc1123z":    time.RFC1123Z,
+	"date":        "2006-01-02",
+	"datetime":    "2006-01-02 15:04:05",
+}
+
+// unixTimeUnits maps the unix* time formats to the unit of their value.
+var unixTimeUnits = map[string]time.Duration{
+	"unix":      time.Second,
+	"unixmilli": time.Millisecond,
+	"unixmicro": time.Microsecond,
+	"unixnano":  time.Nanosecond,
 }
 
+// setTimeField parses val with the layouts listed in the time_format tag.
+// Several layouts may be given separated by "|"; they are tried in order and
+// the first one that parses wins.
 func setTimeField(val string, structField reflect.StructField, value reflect.Value) error {
 	timeFormat := structField.Tag.Get("time_format")
 	if timeFormat == "" {
 		timeFormat = time.RFC3339
 	}
 
-	switch tf := strings.ToLower(timeFormat); tf {
-	case "unix", "unixnano":
-		tv, err := strconv.ParseInt(val, 10, 64)
-		if err != nil {
-			return err
-		}
-
-		d := time.Duration(1)
-		if tf == "unixnano" {
-			d = time.Second
+	var err error
+	for _, layout := range strings.Split(timeFormat, "|") {
+		var t time.Time
+		if t, err = parseTime(val, layout, structField); err == nil {
+			value.Set(reflect.ValueOf(t))
+			return nil
 		}
+	}
 
-		t := time.Unix(tv/int64(d), tv%int64(d))
-		value.Set(reflect.ValueOf(t))
-		return nil
+	switch err.(type) {
+	case *time.ParseError, *strconv.NumError:
+		te := newTypeError(typeTimeTag, val, value.Type(), err)
+		te.param = timeFormat
+		return te
+	}
+	return err
+}
 
+func parseTime(val, layout string, structField reflect.StructField) (time.Time, error) {
+	tf := strings.ToLower(layout)
+	if unit, ok := unixTimeUnits[tf]; ok {
+		tv, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		perSecond := int64(time.Second / unit)
+		return time.Unix(tv/perSecond, tv%perSecond*int64(unit)), nil
 	}
 
 	if val == "" {
-		value.Set(reflect.ValueOf(time.Time{}))
-		return nil
+		return time.Time{}, nil
+	}
+
+	if named, ok := namedTimeLayouts[tf]; ok {
+		layout = named
 	}
 
 	l := time.Local
@@ -314,25 +433,47 @@ func setTimeField(val string, structField reflect.StructField, value reflect.Val
 	if locTag := structField.Tag.Get("time_location"); locTag != "" {
 		loc, err := time.LoadLocation(locTag)
 		if err != nil {
-			return err
+			return time.Time{}, err
 		}
 		l = loc
 	}
 
-	t, err := time.ParseInLocation(timeFormat, val, l)
-	if err != nil {
-		return err
-	}
+	return time.ParseInLocation(layout, val, l)
+}
 
-	value.Set(reflect.ValueOf(t))
-	return nil
+// paramUnmarshaler returns the ParamUnmarshaler implemented by value or its
+// pointer, allocating nil pointers on demand.
+func paramUnmarshaler(value reflect.Value) (ParamUnmarshaler, bool) {
+	if value.Kind() == reflect.Ptr {
+		if _, ok := value.Interface().(ParamUnmarshaler); !ok {
+			return nil, false
+		}
+		if value.IsNil() {
+			if !value.CanSet() {
+				return nil, false
+			}
+			value.Set(reflect.New(value.Type().Elem()))
+		}
+		return value.Interface().(ParamUnmarshaler), true
+	}
+	if value.CanAddr() {
+		u, ok := value.Addr().Interface().(ParamUnmarshaler)
+		return u, ok
+	}
+	return nil, false
 }
 
 func setArray(vals []string, value reflect.Value, field reflect.StructField) error {
 	for i, s := range vals {
-		err := setWithProperType(s, value.Index(i), field)
+		var err error
+		if u, ok := paramUnmarshaler(value.Index(i)); ok {
+			err = u.UnmarshalParam([]string{s})
+		} else {
+			err = setWithProperType(s, value.Index(i), field)
+		}
 		if err != nil {
-			return err
+			idx := "[" + strconv.Itoa(i) + "]"
+			return pushTypeError(err, idx, idx)
 		}
 	}
 	return nil
@@ -351,12 +492,81 @@ func setSlice(vals []string, value reflect.Value, field reflect.StructField) err
 func setTimeDuration(val string, value reflect.Value, field reflect.StructField) error {
 	d, err := time.ParseDuration(val)
 	if err != nil {
-		return err
+		if !strings.HasPrefix(strings.TrimLeft(val, "+-"), "P") {
+			return newTypeError(typeDurationTag, val, value.Type(), err)
+		}
+		if d, err = parseISO8601Duration(val); err != nil {
+			return newTypeError(typeDurationTag, val, value.Type(), err)
+		}
 	}
 	value.Set(reflect.ValueOf(d))
 	return nil
 }
 
+// parseISO8601Duration parses ISO 8601 durations such as P1DT2H or -PT1.5S.
+// Years and months have no fixed length and are therefore rejected.
+func parseISO8601Duration(val string) (time.Duration, error) {
+	invalid := fmt.Errorf("invalid ISO 8601 duration %q", val)
+
+	s, neg := val, false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		s, neg = s[1:], true
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+	if len(s) < 2 || s[0] != 'P' {
+		return 0, invalid
+	}
+	s = s[1:]
+
+	var d time.Duration
+	inTime := false
+	for s != "" {
+		if s[0] == 'T' {
+			if inTime || len(s) == 1 {
+				return 0, invalid
+			}
+			inTime, s = true, s[1:]
+			continue
+		}
+
+		i := strings.IndexFunc(s, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.' && r != ','
+		})
+		if i <= 0 {
+			return 0, invalid
+		}
+		n, err := strconv.ParseFloat(strings.Replace(s[:i], ",", ".", 1), 64)
+		if err != nil {
+			return 0, invalid
+		}
+
+		var unit time.Duration
+		switch {
+		case s[i] == 'W' && !inTime:
+			unit = 7 * 24 * time.Hour
+		case s[i] == 'D' && !inTime:
+			unit = 24 * time.Hour
+		case s[i] == 'H' && inTime:
+			unit = time.Hour
+		case s[i] == 'M' && inTime:
+			unit = time.Minute
+		case s[i] == 'S' && inTime:
+			unit = time.Second
+		default:
+			return 0, invalid
+		}
+		d += time.Duration(n * float64(unit))
+		s = s[i+1:]
+	}
+
+	if neg {
+		d = -d
+	}
+	return d, nil
+}
+
 func head(str, sep string) (head string, tail string) {
 	idx := strings.Index(str, sep)
 	if idx < 0 {
@@ -6,9 +6,15 @@ package binding
 
 import (
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
+	"frames/validator"
+	en_translations "frames/validator/translations/en"
+	english "github.com/go-playground/locales/en"
+	ut "github.com/go-playground/universal-translator"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -279,3 +285,273 @@ func TestMappingIgnoredCircularRef(t *testing.T) {
 	err := mappingByPtr(&s, formSource{}, "form")
 	assert.NoError(t, err)
 }
+
+type dateRange struct {
+	From, To time.Time
+}
+
+func (r *dateRange) UnmarshalParam(values []string) error {
+	from, to := head(values[0], "..")
+	var err error
+	if r.From, err = time.Parse("2006-01-02", from); err != nil {
+		return err
+	}
+	r.To, err = time.Parse("2006-01-02", to)
+	return err
+}
+
+type geoPoint [2]float64
+
+func (p *geoPoint) UnmarshalParam(values []string) error {
+	lat, lng := head(values[0], ",")
+	var err error
+	if p[0], err = strconv.ParseFloat(lat, 64); err != nil {
+		return err
+	}
+	p[1], err = strconv.ParseFloat(lng, 64)
+	return err
+}
+
+type csvTags []string
+
+func (t *csvTags) UnmarshalParam(values []string) error {
+	for _, v := range values {
+		*t = append(*t, strings.Split(v, ",")...)
+	}
+	return nil
+}
+
+func TestMappingParamUnmarshaler(t *testing.T) {
+	var s struct {
+		Range    dateRange    `form:"range"`
+		RangePtr *dateRange   `form:"range_ptr"`
+		Default  dateRange    `form:"default,default=2024-01-01..2024-01-02"`
+		Point    geoPoint     `form:"point"`
+		Points   []geoPoint   `form:"points"`
+		PtrArray [2]*geoPoint `form:"ptr_array"`
+		Tags     csvTags      `form:"tags"`
+	}
+	err := mappingByPtr(&s, formSource{
+		"range":     {"2024-01-01..2024-02-01"},
+		"range_ptr": {"2024-03-01..2024-04-01"},
+		"point":     {"1.5,2.5"},
+		"points":    {"1,2", "3,4"},
+		"ptr_array": {"5,6", "7,8"},
+		"tags":      {"a,b", "c"},
+	}, "form")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "2024-01-01", s.Range.From.Format("2006-01-02"))
+	assert.Equal(t, "2024-02-01", s.Range.To.Format("2006-01-02"))
+	assert.Equal(t, "2024-04-01", s.RangePtr.To.Format("2006-01-02"))
+	assert.Equal(t, "2024-01-02", s.Default.To.Format("2006-01-02"))
+	assert.Equal(t, geoPoint{1.5, 2.5}, s.Point)
+	assert.Equal(t, []geoPoint{{1, 2}, {3, 4}}, s.Points)
+	assert.Equal(t, geoPoint{7, 8}, *s.PtrArray[1])
+	assert.Equal(t, csvTags{"a", "b", "c"}, s.Tags)
+
+	// error
+	err = mappingByPtr(&s, formSource{"range": {"wrong"}}, "form")
+	assert.Error(t, err)
+	err = mappingByPtr(&s, formSource{"points": {"1,x"}}, "form")
+	assert.Error(t, err)
+}
+
+func TestMappingTimeFormats(t *testing.T) {
+	var s struct {
+		Nano   time.Time    `form:"nano" time_format:"rfc3339nano" time_utc:"1"`
+		Date   time.Time    `form:"date" time_format:"date" time_utc:"1"`
+		DT     time.Time    `form:"dt" time_format:"datetime" time_utc:"1"`
+		Milli  time.Time    `form:"milli" time_format:"unixMilli"`
+		Micro  time.Time    `form:"micro" time_format:"unixmicro"`
+		First  time.Time    `form:"first" time_format:"date|unix" time_utc:"1"`
+		Second time.Time    `form:"second" time_format:"date|unix"`
+		Ptr    *time.Time   `form:"ptr" time_format:"date" time_utc:"1"`
+		Slice  []time.Time  `form:"slice" time_format:"date|datetime" time_utc:"1"`
+		PSlice []*time.Time `form:"pslice" time_format:"unix"`
+	}
+	err := mapForm(&s, map[string][]string{
+		"nano":   {"2019-01-20T16:02:58.123456789Z"},
+		"date":   {"2019-01-20"},
+		"dt":     {"2019-01-20 16:02:58"},
+		"milli":  {"1562400033123"},
+		"micro":  {"1562400033123456"},
+		"first":  {"2019-01-20"},
+		"second": {"1562400033"},
+		"ptr":    {"2019-01-20"},
+		"slice":  {"2019-01-20", "2019-01-21 10:00:00"},
+		"pslice": {"1562400033"},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 123456789, s.Nano.Nanosecond())
+	assert.Equal(t, "2019-01-20 00:00:00 +0000 UTC", s.Date.String())
+	assert.Equal(t, "2019-01-20 16:02:58 +0000 UTC", s.DT.String())
+	assert.Equal(t, int64(1562400033123), s.Milli.UnixNano()/int64(time.Millisecond))
+	assert.Equal(t, int64(1562400033123456), s.Micro.UnixNano()/int64(time.Microsecond))
+	assert.Equal(t, "2019-01-20 00:00:00 +0000 UTC", s.First.String())
+	assert.Equal(t, int64(1562400033), s.Second.Unix())
+	assert.Equal(t, "2019-01-20 00:00:00 +0000 UTC", s.Ptr.String())
+	assert.Equal(t, "2019-01-21 10:00:00 +0000 UTC", s.Slice[1].String())
+	assert.Equal(t, int64(1562400033), s.PSlice[0].Unix())
+
+	// no layout matches
+	err = mapForm(&s, map[string][]string{"first": {"wrong"}})
+	assert.Error(t, err)
+}
+
+func TestMappingTimeFormatsHeaderAndURI(t *testing.T) {
+	var h struct {
+		Since time.Time `header:"X-Since" time_format:"unixmilli"`
+	}
+	err := mapHeader(&h, map[string][]string{"X-Since": {"1562400033123"}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1562400033123), h.Since.UnixNano()/int64(time.Millisecond))
+
+	var u struct {
+		Day time.Time `uri:"day" time_format:"date" time_utc:"1"`
+	}
+	err = mapUri(&u, map[string][]string{"day": {"2019-01-20"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "2019-01-20 00:00:00 +0000 UTC", u.Day.String())
+}
+
+func TestMappingTimeDurationISO8601(t *testing.T) {
+	for _, tt := range []struct {
+		form   string
+		expect time.Duration
+	}{
+		{"P1DT2H", 26 * time.Hour},
+		{"PT1.5S", 1500 * time.Millisecond},
+		{"PT2M", 2 * time.Minute},
+		{"P1W", 7 * 24 * time.Hour},
+		{"-PT1H30M", -90 * time.Minute},
+	} {
+		var s struct {
+			D time.Duration
+		}
+		err := mappingByPtr(&s, formSource{"D": {tt.form}}, "form")
+		assert.NoError(t, err, tt.form)
+		assert.Equal(t, tt.expect, s.D, tt.form)
+	}
+
+	for _, form := range []string{"P", "PT", "P1M", "P1Y", "PT1D", "P1H", "P1DT", "PTS"} {
+		var s struct {
+			D time.Duration
+		}
+		err := mappingByPtr(&s, formSource{"D": {form}}, "form")
+		assert.Error(t, err, form)
+	}
+}
+
+func TestMappingPrefixedMapField(t *testing.T) {
+	type env string
+	var s struct {
+		Labels  map[string]string    `form:"labels"`
+		Limits  map[string]int       `form:"limits"`
+		Tags    map[env][]string     `form:"tags"`
+		Windows map[string]time.Time `form:"windows" time_format:"date" time_utc:"1"`
+		Ptr     *map[string]float64  `form:"ptr"`
+		Unset   map[string]string    `form:"unset"`
+		Nested  map[string]string    `form:"nested"`
+		JSON    map[string]int       `form:"json"`
+		IntKeys map[int]string       `form:"int_keys"`
+	}
+	err := mapForm(&s, map[string][]string{
+		"labels[env]":    {"prod"},
+		"labels[team]":   {"core"},
+		"limits[cpu]":    {"2"},
+		"limits[mem]":    {"512"},
+		"tags[a]":        {"x", "y"},
+		"windows[start]": {"2024-01-01"},
+		"ptr[ratio]":     {"0.5"},
+		"nested[a][b]":   {"skipped"},
+		"json":           {`{"one": 1}`},
+		"int_keys[1]":    {"one"},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"env": "prod", "team": "core"}, s.Labels)
+	assert.Equal(t, map[string]int{"cpu": 2, "mem": 512}, s.Limits)
+	assert.Equal(t, map[env][]string{"a": {"x", "y"}}, s.Tags)
+	assert.Equal(t, "2024-01-01 00:00:00 +0000 UTC", s.Windows["start"].String())
+	assert.Equal(t, map[string]float64{"ratio": 0.5}, *s.Ptr)
+	assert.Nil(t, s.Unset)
+	assert.Nil(t, s.Nested)
+	assert.Equal(t, map[string]int{"one": 1}, s.JSON)
+	assert.Nil(t, s.IntKeys)
+
+	// conversion error
+	err = mapForm(&s, map[string][]string{"limits[cpu]": {"wrong"}})
+	assert.Error(t, err)
+}
+
+func TestMappingPrefixedMapFieldMaxKeys(t *testing.T) {
+	defer func(n int) { MaxFormMapKeys = n }(MaxFormMapKeys)
+	MaxFormMapKeys = 1
+
+	var s struct {
+		Labels map[string]string `form:"labels"`
+	}
+	err := mapForm(&s, map[string][]string{"labels[a]": {"1"}})
+	assert.NoError(t, err)
+
+	err = mapForm(&s, map[string][]string{"labels[a]": {"1"}, "labels[b]": {"2"}})
+	assert.Error(t, err)
+}
+
+func TestMappingTypeError(t *testing.T) {
+	type Address struct {
+		Zip int `form:"zip"`
+	}
+	type User struct {
+		Age     uint          `form:"age"`
+		Ratio   float32       `form:"ratio"`
+		Active  bool          `form:"active"`
+		Born    time.Time     `form:"born" time_format:"date"`
+		TTL     time.Duration `form:"ttl"`
+		Scores  []int         `form:"scores"`
+		Limits  map[string]int
+		Address Address
+	}
+
+	trans := enTranslator(t)
+	for _, tt := range []struct {
+		form        map[string][]string
+		tag         string
+		ns          string
+		structNs    string
+		param       string
+		translation string
+	}{
+		{map[string][]string{"age": {"-1"}}, "type_uint", "User.age", "User.Age", "", "age must be a valid non-negative integer"},
+		{map[string][]string{"ratio": {"x"}}, "type_float", "User.ratio", "User.Ratio", "", "ratio must be a valid number"},
+		{map[string][]string{"active": {"x"}}, "type_bool", "User.active", "User.Active", "", "active must be a valid boolean"},
+		{map[string][]string{"born": {"x"}}, "type_time", "User.born", "User.Born", "date", "born must be a valid time"},
+		{map[string][]string{"ttl": {"x"}}, "type_duration", "User.ttl", "User.TTL", "", "ttl must be a valid duration"},
+		{map[string][]string{"scores": {"1", "x"}}, "type_int", "User.scores[1]", "User.Scores[1]", "", "scores[1] must be a valid integer"},
+		{map[string][]string{"Limits[cpu]": {"x"}}, "type_int", "User.Limits[cpu]", "User.Limits[cpu]", "", "Limits[cpu] must be a valid integer"},
+		{map[string][]string{"zip": {"x"}}, "type_int", "User.Address.zip", "User.Address.Zip", "", "zip must be a valid integer"},
+	} {
+		var u User
+		err := mapForm(&u, tt.form)
+
+		errs, ok := err.(validator.ValidationErrors)
+		if assert.True(t, ok, tt.ns) && assert.Len(t, errs, 1, tt.ns) {
+			fe := errs[0]
+			assert.Equal(t, tt.tag, fe.Tag())
+			assert.Equal(t, tt.ns, fe.Namespace())
+			assert.Equal(t, tt.structNs, fe.StructNamespace())
+			assert.Equal(t, tt.param, fe.Param())
+			assert.Equal(t, tt.translation, fe.Translate(trans))
+		}
+	}
+}
+
+func enTranslator(t *testing.T) ut.Translator {
+	eng := english.New()
+	trans, _ := ut.New(eng, eng).GetTranslator("en")
+	v := Validator.Engine().(*validator.Validate)
+	assert.NoError(t, en_translations.RegisterDefaultTranslations(v, trans))
+	return trans
+}
@@ -18,7 +18,7 @@ func (headerBinding) Bind(req *http.Request, obj interface{}) error {
 		return err
 	}
 
-	return validate(obj)
+	return validateCtx(req.Context(), obj)
 }
 
 func mapHeader(ptr interface{}, h map[string][]string) error {
@@ -5,6 +5,10 @@
 package binding
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -28,3 +32,34 @@ func TestJSONBindingBindBodyMap(t *testing.T) {
 	assert.Equal(t, "FOO", s["foo"])
 	assert.Equal(t, "world", s["hello"])
 }
+
+func TestJSONBindingBindCanceled(t *testing.T) {
+	var s struct {
+		Foo string `json:"foo" binding:"required"`
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", strings.NewReader(`{"foo": "FOO"}`))
+	require.NoError(t, err)
+
+	err = jsonBinding{}.Bind(req, &s)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, "FOO", s.Foo)
+}
+
+func TestJSONBindingBindModifiers(t *testing.T) {
+	var s struct {
+		Email string `json:"email" mod:"trim,lower" binding:"email"`
+	}
+
+	err := jsonBinding{}.BindBody([]byte(`{"email": " Foo@Example.com "}`), &s)
+	assert.Error(t, err)
+
+	EnableModifiers = true
+	defer func() { EnableModifiers = false }()
+
+	err = jsonBinding{}.BindBody([]byte(`{"email": " Foo@Example.com "}`), &s)
+	require.NoError(t, err)
+	assert.Equal(t, "foo@example.com", s.Email)
+}
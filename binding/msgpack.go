@@ -9,6 +9,7 @@ package binding
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"net/http"
 
@@ -22,17 +23,17 @@ func (msgpackBinding) Name() string {
 }
 
 func (msgpackBinding) Bind(req *http.Request, obj interface{}) error {
-	return decodeMsgPack(req.Body, obj)
+	return decodeMsgPack(req.Context(), req.Body, obj)
 }
 
 func (msgpackBinding) BindBody(body []byte, obj interface{}) error {
-	return decodeMsgPack(bytes.NewReader(body), obj)
+	return decodeMsgPack(context.Background(), bytes.NewReader(body), obj)
 }
 
-func decodeMsgPack(r io.Reader, obj interface{}) error {
+func decodeMsgPack(ctx context.Context, r io.Reader, obj interface{}) error {
 	cdc := new(codec.MsgpackHandle)
 	if err := codec.NewDecoder(r, cdc).Decode(&obj); err != nil {
 		return err
 	}
-	return validate(obj)
+	return validateCtx(ctx, obj)
 }
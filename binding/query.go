@@ -17,5 +17,5 @@ func (queryBinding) Bind(req *http.Request, obj interface{}) error {
 	if err := mapForm(obj, values); err != nil {
 		return err
 	}
-	return validate(obj)
+	return validateCtx(req.Context(), obj)
 }
@@ -0,0 +1,33 @@
+package binding
+
+import "sync"
+
+// requests are the request structs registered with RegisterRequest.
+var requests struct {
+	sync.Mutex
+	objs []interface{}
+}
+
+// RegisterRequest records the request structs bound by a route, so that
+// Precompile verifies them along with any it is given, e.g. when routes are
+// registered with a router:
+//
+//	router.GET("/bookable", getBookable)
+//	binding.RegisterRequest(Booking{})
+//	...
+//	if err := binding.Precompile(); err != nil {
+//		log.Fatal(err)
+//	}
+func RegisterRequest(objs ...interface{}) {
+	requests.Lock()
+	defer requests.Unlock()
+	requests.objs = append(requests.objs, objs...)
+}
+
+// registeredRequests returns the request structs registered with
+// RegisterRequest.
+func registeredRequests() []interface{} {
+	requests.Lock()
+	defer requests.Unlock()
+	return append([]interface{}(nil), requests.objs...)
+}
@@ -0,0 +1,79 @@
+package binding
+
+import (
+	"reflect"
+	"strings"
+
+	"frames/validator"
+)
+
+// Validation tags reported for request values that cannot be converted to
+// the type of the field they are bound to. Translations for them ship with
+// every locale in validator/translations.
+const (
+	typeIntTag      = "type_int"
+	typeUintTag     = "type_uint"
+	typeFloatTag    = "type_float"
+	typeBoolTag     = "type_bool"
+	typeTimeTag     = "type_time"
+	typeDurationTag = "type_duration"
+)
+
+// typeError describes a conversion failure while mapping request values. The
+// namespace is collected in reverse while the error bubbles up through
+// mapping and turned into a validator.FieldError by mappingByPtr.
+type typeError struct {
+	tag      string
+	value    string
+	param    string
+	typ      reflect.Type
+	ns       []string
+	structNs []string
+	err      error
+}
+
+func newTypeError(tag, value string, typ reflect.Type, err error) *typeError {
+	return &typeError{tag: tag, value: value, typ: typ, err: err}
+}
+
+func (e *typeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *typeError) Unwrap() error {
+	return e.err
+}
+
+// push prepends a namespace element; name is the tag name and structName the
+// field's actual name. Elements starting with '[' are joined without a dot.
+func (e *typeError) push(name, structName string) {
+	e.ns = append(e.ns, name)
+	e.structNs = append(e.structNs, structName)
+}
+
+func (e *typeError) fieldError() validator.FieldError {
+	var v *validator.Validate
+	if Validator != nil {
+		v, _ = Validator.Engine().(*validator.Validate)
+	}
+	return v.NewFieldError(e.tag, joinNamespace(e.ns), joinNamespace(e.structNs), e.value, e.param, e.typ)
+}
+
+// pushTypeError prepends a namespace element to err if it is a typeError.
+func pushTypeError(err error, name, structName string) error {
+	if te, ok := err.(*typeError); ok {
+		te.push(name, structName)
+	}
+	return err
+}
+
+func joinNamespace(reversed []string) string {
+	var b strings.Builder
+	for i := len(reversed) - 1; i >= 0; i-- {
+		if b.Len() > 0 && !strings.HasPrefix(reversed[i], "[") {
+			b.WriteByte('.')
+		}
+		b.WriteString(reversed[i])
+	}
+	return b.String()
+}
@@ -226,3 +226,36 @@ func TestValidatorEngine(t *testing.T) {
 	// Check that the error matches expectation
 	assert.Error(t, errs, "", "", "notone")
 }
+
+func TestPrecompile(t *testing.T) {
+	type precompileStruct struct {
+		Integer int `binding:"required,mni=1"`
+	}
+
+	assert.NoError(t, Precompile(structCustomValidation{}))
+	assert.Error(t, Precompile(precompileStruct{}))
+
+	validatorBackup := Validator
+	Validator = nil
+	defer func() { Validator = validatorBackup }()
+	assert.NoError(t, Precompile(precompileStruct{}))
+}
+
+func TestPrecompileRegisteredRequests(t *testing.T) {
+	type validStruct struct {
+		Integer int `binding:"required,min=1"`
+	}
+	type precompileStruct struct {
+		Integer int `binding:"required,mni=1"`
+	}
+
+	defer func() { requests.objs = nil }()
+
+	RegisterRequest(validStruct{})
+	assert.NoError(t, Precompile())
+
+	RegisterRequest(&precompileStruct{})
+	assert.Error(t, Precompile())
+	assert.Error(t, Precompile(validStruct{}))
+	assert.Len(t, registeredRequests(), 2)
+}
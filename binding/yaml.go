@@ -6,6 +6,7 @@ package binding
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"net/http"
 
@@ -19,17 +20,17 @@ func (yamlBinding) Name() string {
 }
 
 func (yamlBinding) Bind(req *http.Request, obj interface{}) error {
-	return decodeYAML(req.Body, obj)
+	return decodeYAML(req.Context(), req.Body, obj)
 }
 
 func (yamlBinding) BindBody(body []byte, obj interface{}) error {
-	return decodeYAML(bytes.NewReader(body), obj)
+	return decodeYAML(context.Background(), bytes.NewReader(body), obj)
 }
 
-func decodeYAML(r io.Reader, obj interface{}) error {
+func decodeYAML(ctx context.Context, r io.Reader, obj interface{}) error {
 	decoder := yaml.NewDecoder(r)
 	if err := decoder.Decode(obj); err != nil {
 		return err
 	}
-	return validate(obj)
+	return validateCtx(ctx, obj)
 }
@@ -0,0 +1,503 @@
+// Package lint provides an analyzer checking validate and binding struct tags
+// without running the program.
+//
+// Misspelled tags and malformed params otherwise only show up as a panic the
+// first time a struct is validated, and references to missing fields as rules
+// which always fail. The analyzer reports:
+//
+//   - unknown validations and tag syntax errors
+//   - malformed params, such as a non-numeric min on a numeric field
+//   - eqfield, required_with and similar rules referencing missing fields
+//   - dive on types other than slices, arrays and maps, and keys on non-maps
+//
+// Validations and aliases registered at run time are declared in a JSON
+// config file given with the -config flag:
+//
+//	{
+//		"validations": ["is-awesome"],
+//		"aliases": {"iscolour": "hexcolor|rgb|rgba"}
+//	}
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"frames/validator"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// Analyzer checks the validate and binding tags of the structs of a package.
+var Analyzer = &analysis.Analyzer{
+	Name:     "validatelint",
+	Doc:      "check validate and binding struct tags",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+var (
+	configFile string
+	tagNames   string
+)
+
+func init() {
+	Analyzer.Flags.StringVar(&configFile, "config", "", "JSON `file` declaring custom validations and aliases")
+	Analyzer.Flags.StringVar(&tagNames, "tag", "validate,binding", "comma separated struct tag `names` to check")
+}
+
+// numberTags are the validations whose param is a number, or a length for
+// strings, slices, arrays and maps.
+var numberTags = map[string]bool{
+	"len": true,
+	"min": true,
+	"max": true,
+	"eq":  true,
+	"ne":  true,
+	"gt":  true,
+	"gte": true,
+	"lt":  true,
+	"lte": true,
+}
+
+// fieldTags are the validations whose param is a field of the parent struct.
+var fieldTags = map[string]bool{
+	"eqfield":       true,
+	"nefield":       true,
+	"gtfield":       true,
+	"gtefield":      true,
+	"ltfield":       true,
+	"ltefield":      true,
+	"fieldcontains": true,
+	"fieldexcludes": true,
+}
+
+// fieldListTags are the validations whose param is a space separated list of
+// fields of the parent struct.
+var fieldListTags = map[string]bool{
+	"required_with":        true,
+	"required_with_all":    true,
+	"required_without":     true,
+	"required_without_all": true,
+	"excluded_with":        true,
+	"excluded_with_all":    true,
+	"excluded_without":     true,
+	"excluded_without_all": true,
+}
+
+// fieldValueTags are the validations whose param is a space separated list of
+// field and value pairs.
+var fieldValueTags = map[string]bool{
+	"required_if":     true,
+	"required_unless": true,
+}
+
+// config declares validations and aliases which are registered at run time.
+type config struct {
+	Validations []string          `json:"validations"`
+	Aliases     map[string]string `json:"aliases"`
+}
+
+// linter checks the tags of the structs of a package.
+type linter struct {
+	v        *validator.Validate
+	tagNames []string
+	pass     *analysis.Pass
+}
+
+func newLinter(cfg *config, tagNames []string) (l *linter, err error) {
+	l = &linter{v: validator.New(), tagNames: tagNames}
+
+	// restricted tags and aliases panic
+	defer func() {
+		if r := recover(); r != nil {
+			l, err = nil, fmt.Errorf("%v", r)
+		}
+	}()
+
+	for _, tag := range cfg.Validations {
+		if err = l.v.RegisterValidation(tag, func(validator.FieldLevel) bool { return true }); err != nil {
+			return nil, err
+		}
+	}
+	for alias, tags := range cfg.Aliases {
+		l.v.RegisterAlias(alias, tags)
+	}
+	return l, nil
+}
+
+// loadConfig reads the config file given with the -config flag, if any.
+func loadConfig() (*config, error) {
+	var cfg config
+	if configFile == "" {
+		return &cfg, nil
+	}
+	b, err := ioutil.ReadFile(configFile)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(b, &cfg); err != nil {
+		return nil, fmt.Errorf("%s: %v", configFile, err)
+	}
+	return &cfg, nil
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := newLinter(cfg, strings.Split(tagNames, ","))
+	if err != nil {
+		return nil, err
+	}
+	l.pass = pass
+
+	ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	ins.Preorder([]ast.Node{(*ast.StructType)(nil)}, func(n ast.Node) {
+		st := n.(*ast.StructType)
+		if s, ok := pass.TypesInfo.TypeOf(st).(*types.Struct); ok {
+			l.checkStruct(st, s)
+		}
+	})
+	return nil, nil
+}
+
+func (l *linter) reportf(pos token.Pos, format string, args ...interface{}) {
+	l.pass.Reportf(pos, format, args...)
+}
+
+func (l *linter) checkStruct(st *ast.StructType, s *types.Struct) {
+	// a field declaration may declare several fields
+	var fields []*ast.Field
+	for _, f := range st.Fields.List {
+		n := len(f.Names)
+		if n == 0 {
+			n = 1
+		}
+		for i := 0; i < n; i++ {
+			fields = append(fields, f)
+		}
+	}
+	if len(fields) != s.NumFields() {
+		return
+	}
+
+	for i := 0; i < s.NumFields(); i++ {
+		fld := s.Field(i)
+
+		// the validator skips unexported fields
+		if !fld.Anonymous() && !fld.Exported() {
+			continue
+		}
+
+		pos := fields[i].Pos()
+		if fields[i].Tag != nil {
+			pos = fields[i].Tag.Pos()
+		}
+
+		tag := reflect.StructTag(s.Tag(i))
+		for _, name := range l.tagNames {
+			val := tag.Get(name)
+			if val == "" || val == "-" {
+				continue
+			}
+			for _, rules := range validator.GroupSections(val) {
+				l.checkTag(pos, s, fld, name, rules)
+			}
+		}
+	}
+}
+
+// checkTag checks the tag of fld, a field of parent.
+func (l *linter) checkTag(pos token.Pos, parent *types.Struct, fld *types.Var, tagName, tag string) {
+	if err := l.parse(tag); err != nil {
+		l.reportf(pos, "%s: %s in %s tag %q", fld.Name(), err, tagName, tag)
+		return
+	}
+
+	typ := fld.Type()
+	var container types.Type
+
+	for _, term := range splitTag(tag, ",") {
+		switch term {
+		case "dive":
+			elem := elemType(typ)
+			if elem == nil {
+				l.reportf(pos, "%s: dive on non-collection type %s", fld.Name(), typ)
+				return
+			}
+			container, typ = typ, elem
+
+		case "keys":
+			m, ok := deref(container).Underlying().(*types.Map)
+			if !ok {
+				l.reportf(pos, "%s: keys on non-map type %s", fld.Name(), container)
+				return
+			}
+			typ = m.Key()
+
+		case "endkeys":
+			typ = elemType(container)
+
+		default:
+			for _, r := range splitRules(term) {
+				if err := l.checkRule(parent, fld, typ, r); err != nil {
+					l.reportf(pos, "%s: %v", fld.Name(), err)
+				}
+			}
+		}
+	}
+}
+
+// parse parses tag the way the validator does, reporting its panics as an
+// error.
+func (l *linter) parse(tag string) (err error) {
+	defer func() {
+		switch r := recover().(type) {
+		case nil:
+		case *validator.TagSyntaxError:
+			err = fmt.Errorf("%s at column %d", r.Msg, r.Column)
+		default:
+			err = fmt.Errorf("%s", strings.TrimSuffix(fmt.Sprint(r), " on field ''"))
+		}
+	}()
+
+	// validating nil only parses the tag, as there is nothing to validate
+	_ = l.v.Var(nil, tag)
+	return nil
+}
+
+// rule is a single validation within a tag.
+type rule struct {
+	name     string
+	param    string
+	hasParam bool
+}
+
+func (l *linter) checkRule(parent *types.Struct, fld *types.Var, typ types.Type, r rule) error {
+	switch {
+	case numberTags[r.name] && r.hasParam:
+		if err := checkNumber(r.name, typ, r.param); err != nil {
+			return fmt.Errorf("malformed param %q for '%s': %v", r.param, r.name, err)
+		}
+
+	case r.name == "oneof" && isNumeric(typ):
+		for _, val := range strings.Fields(r.param) {
+			if err := checkNumber(r.name, typ, val); err != nil {
+				return fmt.Errorf("malformed value %q in '%s': %v", val, r.name, err)
+			}
+		}
+
+	case fieldTags[r.name]:
+		return l.checkFields(parent, fld, r, []string{r.param})
+
+	case fieldListTags[r.name]:
+		return l.checkFields(parent, fld, r, strings.Fields(r.param))
+
+	case fieldValueTags[r.name]:
+		params := strings.Fields(r.param)
+		var names []string
+		for i := 0; i < len(params); i += 2 {
+			names = append(names, params[i])
+		}
+		return l.checkFields(parent, fld, r, names)
+	}
+	return nil
+}
+
+func (l *linter) checkFields(parent *types.Struct, fld *types.Var, r rule, names []string) error {
+	for _, name := range names {
+		if !hasField(parent, fld.Pkg(), name) {
+			return fmt.Errorf("'%s' references unknown field %q", r.name, name)
+		}
+	}
+	return nil
+}
+
+// hasField reports whether the namespace ns, eg. Inner.Items[0].Name, can be
+// resolved from s. It returns true if it cannot tell, such as for interfaces.
+func hasField(s *types.Struct, pkg *types.Package, ns string) bool {
+	var typ types.Type = s
+
+	for _, name := range strings.Split(ns, ".") {
+		brackets := strings.Count(name, "[")
+		if idx := strings.IndexByte(name, '['); idx != -1 {
+			name = name[:idx]
+		}
+
+		if _, ok := deref(typ).Underlying().(*types.Struct); !ok {
+			return true
+		}
+		obj, _, _ := types.LookupFieldOrMethod(typ, true, pkg, name)
+		v, ok := obj.(*types.Var)
+		if !ok || !v.IsField() {
+			return false
+		}
+
+		typ = v.Type()
+		for i := 0; i < brackets && typ != nil; i++ {
+			typ = elemType(typ)
+		}
+		if typ == nil {
+			return true
+		}
+	}
+	return true
+}
+
+// checkNumber checks param of the number validation tag for a field of type
+// typ, the same way the validator parses it.
+func checkNumber(tag string, typ types.Type, param string) error {
+	t := deref(typ)
+
+	if isNamed(t, "time", "Duration") {
+		if _, err := time.ParseDuration(param); err == nil {
+			return nil
+		}
+		_, err := strconv.ParseInt(param, 0, 64)
+		return expect(err, "a duration or integer")
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		info := u.Info()
+		switch {
+		case info&types.IsString != 0:
+			if tag == "eq" || tag == "ne" || tag == "oneof" {
+				return nil
+			}
+			_, err := strconv.ParseInt(param, 0, 64)
+			return expect(err, "an integer length")
+		case info&types.IsUnsigned != 0:
+			_, err := strconv.ParseUint(param, 0, 64)
+			return expect(err, "an unsigned integer")
+		case info&types.IsInteger != 0:
+			_, err := strconv.ParseInt(param, 0, 64)
+			return expect(err, "an integer")
+		case info&types.IsFloat != 0:
+			_, err := strconv.ParseFloat(param, 64)
+			return expect(err, "a number")
+		case info&types.IsBoolean != 0:
+			_, err := strconv.ParseBool(param)
+			return expect(err, "a bool")
+		}
+
+	case *types.Slice, *types.Array, *types.Map:
+		_, err := strconv.ParseInt(param, 0, 64)
+		return expect(err, "an integer length")
+	}
+	return nil
+}
+
+// expect turns a parse error into an error describing the expected param.
+func expect(err error, want string) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("expected %s", want)
+}
+
+func isNumeric(typ types.Type) bool {
+	b, ok := deref(typ).Underlying().(*types.Basic)
+	return ok && b.Info()&types.IsNumeric != 0
+}
+
+func isNamed(t types.Type, pkgPath, name string) bool {
+	n, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := n.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == pkgPath && obj.Name() == name
+}
+
+func deref(t types.Type) types.Type {
+	if t == nil {
+		return types.Typ[types.Invalid]
+	}
+	for {
+		p, ok := t.Underlying().(*types.Pointer)
+		if !ok {
+			return t
+		}
+		t = p.Elem()
+	}
+}
+
+// elemType returns the element type of a slice, array or map, or nil.
+func elemType(t types.Type) types.Type {
+	switch u := deref(t).Underlying().(type) {
+	case *types.Slice:
+		return u.Elem()
+	case *types.Array:
+		return u.Elem()
+	case *types.Map:
+		return u.Elem()
+	}
+	return nil
+}
+
+// splitRules returns the validations of a term, eg. (min=1|!eq=5), ignoring
+// grouping and negation.
+func splitRules(term string) []rule {
+	if parts := splitTag(term, ",|"); len(parts) > 1 {
+		var rules []rule
+		for _, t := range parts {
+			rules = append(rules, splitRules(t)...)
+		}
+		return rules
+	}
+
+	term = strings.TrimLeft(term, "!")
+	if strings.HasPrefix(term, "(") && strings.HasSuffix(term, ")") {
+		return splitRules(term[1 : len(term)-1])
+	}
+
+	if idx := strings.IndexByte(term, '='); idx != -1 {
+		return []rule{{name: term[:idx], param: term[idx+1:], hasParam: true}}
+	}
+	return []rule{{name: term}}
+}
+
+// splitTag splits tag on the separators in seps which are not within
+// parentheses or quotes, or part of an expression.
+func splitTag(tag string, seps string) []string {
+	var parts []string
+	start, depth := 0, 0
+	isExpr := false
+
+	for i := 0; i < len(tag); i++ {
+		c := tag[i]
+		switch {
+		case c == '\'':
+			if idx := strings.IndexByte(tag[i+1:], '\''); idx != -1 {
+				i += idx + 1
+			}
+		case c == '(':
+			depth++
+		case c == ')' && depth > 0:
+			depth--
+		case c == '|' && isExpr && strings.HasPrefix(tag[i:], "||"):
+			i++
+		case c == '=' && !isExpr:
+			name := strings.TrimLeft(tag[start:i], "!(")
+			isExpr = name == "expr" || name == "when"
+		case depth == 0 && strings.IndexByte(seps, c) != -1:
+			parts = append(parts, tag[start:i])
+			start = i + 1
+			isExpr = false
+		}
+	}
+	return append(parts, tag[start:])
+}
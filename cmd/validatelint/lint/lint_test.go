@@ -0,0 +1,28 @@
+package lint
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	testdata := analysistest.TestData()
+	if err := Analyzer.Flags.Set("config", filepath.Join(testdata, "config.json")); err != nil {
+		t.Fatal(err)
+	}
+	defer Analyzer.Flags.Set("config", "")
+
+	analysistest.Run(t, testdata, Analyzer, "a")
+}
+
+func TestNewLinterConfigErrors(t *testing.T) {
+	if _, err := newLinter(&config{Validations: []string{"dive"}}, nil); err == nil || !strings.Contains(err.Error(), "dive") {
+		t.Errorf("expected an error for a restricted validation, got %v", err)
+	}
+	if _, err := newLinter(&config{Aliases: map[string]string{"omitempty": "min=1"}}, nil); err == nil {
+		t.Error("expected an error for a restricted alias")
+	}
+}
@@ -0,0 +1,31 @@
+package a
+
+import "time"
+
+type Inner struct {
+	Name string
+}
+
+type Config struct {
+	Name     string         `validate:"required,min=1,max=64"`
+	Port     int            `validate:"min=1,max=65535x"` // want `Port: malformed param "65535x" for 'max': expected an integer`
+	Ratio    float64        `validate:"gt=0.5,lt=one"`    // want `Ratio: malformed param "one" for 'lt': expected a number`
+	Timeout  time.Duration  `validate:"min=1s,max=1h"`
+	Retries  uint           `validate:"oneof=1 2 -3"`               // want `Retries: malformed value "-3" in 'oneof': expected an unsigned integer`
+	Tags     []string       `validate:"max=10,dive,required,min=x"` // want `Tags: malformed param "x" for 'min': expected an integer length`
+	Labels   map[string]int `validate:"dive,keys,alpha,endkeys,min=0"`
+	Mode     string         `validate:"requird"`        // want `Mode: Undefined validation function 'requird' in validate tag "requird"`
+	Group    string         `validate:"(alpha|numeric"` // want `Group: missing closing '\)' at column 1 in validate tag`
+	Password string         `validate:"required"`
+	Confirm  string         `validate:"eqfield=Pasword"`                   // want `Confirm: 'eqfield' references unknown field "Pasword"`
+	Other    string         `binding:"required_with=Inner.Name Inner.Nme"` // want `Other: 'required_with' references unknown field "Inner.Nme"`
+	Country  string         `binding:"required_if=Mode a Missing b"`       // want `Country: 'required_if' references unknown field "Missing"`
+	Inner    *Inner
+	Count    int    `validate:"dive,min=1"`                // want `Count: dive on non-collection type int`
+	Pairs    []int  `validate:"dive,keys,min=1,endkeys"`   // want `Pairs: keys on non-map type \[\]int`
+	Custom   string `validate:"is-awesome,(min=1|!max=x)"` // want `Custom: malformed param "x" for 'max': expected an integer length`
+	Expr     int    `validate:"expr=this > 0 || Port == 1,min=2"`
+	Grouped  string `validate:"create:required,min=1;update:omitempty,max=y"` // want `Grouped: malformed param "y" for 'max'`
+	Color    string `validate:"iscolor|iscolour"`
+	ignored  string `validate:"nonsense"`
+}
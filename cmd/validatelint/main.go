@@ -0,0 +1,20 @@
+// Command validatelint checks validate and binding struct tags without running
+// the program, see package lint.
+//
+// Usage:
+//
+//	validatelint [-config file] [-tag validate,binding] [packages]
+//
+// It may also be run by go vet:
+//
+//	go vet -vettool=$(which validatelint) [packages]
+package main
+
+import (
+	"frames/cmd/validatelint/lint"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(lint.Analyzer)
+}
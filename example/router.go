@@ -54,6 +54,12 @@ func Router(){
 	router.GET("/hello/:name", Hello)
 	router.GET("/bookable", getBookable)
 	router.GET("/bookable1", timeMiddleware(getBookable))
+	binding.RegisterRequest(Booking{})
+
+	// verify the rules of the request structs before serving
+	if err := binding.Precompile(); err != nil {
+		log.Fatal(err)
+	}
 
 	log.Fatal(http.ListenAndServe(":8085", router))
 }
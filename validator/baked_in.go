@@ -79,6 +79,11 @@ var (
 		"excluded_with_all":             excludedWithAll,
 		"excluded_without":              excludedWithout,
 		"excluded_without_all":          excludedWithoutAll,
+		"when":                          requiredWhen,
+		"expr":                          isExprTrue,
+		"immutable":                     isImmutable,
+		"transition":                    isTransition,
+		"monotonic":                     isMonotonic,
 		"isdefault":                     isDefault,
 		"len":                           hasLengthOf,
 		"min":                           hasMinOf,
@@ -1618,6 +1623,25 @@ func excludedWithoutAll(fl FieldLevel) bool {
 	return !hasValue(fl)
 }
 
+// requiredWhen is the validation function
+// The field under validation must be present and not empty only if the expression in the param evaluates to true.
+func requiredWhen(fl FieldLevel) bool {
+	ok, err := exprProgramOf(fl).eval(fl)
+	if err != nil {
+		return false
+	}
+	if !ok {
+		return true
+	}
+	return hasValue(fl)
+}
+
+// isExprTrue is the validation function for validating that the expression in the param evaluates to true.
+func isExprTrue(fl FieldLevel) bool {
+	ok, err := exprProgramOf(fl).eval(fl)
+	return ok && err == nil
+}
+
 // RequiredWithoutAll is the validation function
 // The field under validation must be present and not empty only when all of the other specified fields are not present.
 func requiredWithoutAll(fl FieldLevel) bool {
@@ -84,6 +84,17 @@ func BenchmarkFieldArrayDiveFailure(b *testing.B) {
 	}
 }
 
+func BenchmarkFieldArrayDiveFailureFailFast(b *testing.B) {
+	validate := New()
+	validate.SetFailFast(true)
+	m := []string{"", "", ""}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = validate.Var(m, "required,dive,required")
+	}
+}
+
 func BenchmarkFieldArrayDiveFailureParallel(b *testing.B) {
 	validate := New()
 	m := []string{"val1", "", "val3"}
@@ -1040,6 +1051,39 @@ func BenchmarkStructComplexFailure(b *testing.B) {
 	}
 }
 
+func BenchmarkStructComplexFailureFailFast(b *testing.B) {
+	validate := New()
+	validate.SetFailFast(true)
+	tFail := &TestString{
+		Required:  "",
+		Len:       "",
+		Min:       "",
+		Max:       "12345678901",
+		MinMax:    "",
+		Lt:        "0123456789",
+		Lte:       "01234567890",
+		Gt:        "1",
+		Gte:       "1",
+		OmitEmpty: "12345678901",
+		Sub: &SubTest{
+			Test: "",
+		},
+		Anonymous: struct {
+			A string `validate:"required"`
+		}{
+			A: "",
+		},
+		Iface: &Impl{
+			F: "12",
+		},
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = validate.Struct(tFail)
+	}
+}
+
 func BenchmarkStructComplexFailureParallel(b *testing.B) {
 	validate := New()
 	tFail := &TestString{
@@ -1,11 +1,13 @@
 package validator
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"unicode"
 )
 
 type tagType uint8
@@ -23,14 +25,14 @@ const (
 )
 
 const (
-	invalidValidation   = "Invalid validation tag on field '%s'"
 	undefinedValidation = "Undefined validation function '%s' on field '%s'"
 	keysTagNotDefined   = "'" + endKeysTag + "' tag encountered without a corresponding '" + keysTag + "' tag"
 )
 
 type structCache struct {
-	lock sync.Mutex
-	m    atomic.Value // map[reflect.Type]*cStruct
+	lock   sync.Mutex
+	m      atomic.Value        // map[reflect.Type]*cStruct
+	groups map[string]struct{} // validation groups the cached structs were built for, nil for the default cache
 }
 
 func (sc *structCache) Get(key reflect.Type) (c *cStruct, found bool) {
@@ -48,6 +50,27 @@ func (sc *structCache) Set(key reflect.Type, value *cStruct) {
 	sc.m.Store(nm)
 }
 
+// groupCache holds one structCache per distinct set of validation groups.
+type groupCache struct {
+	lock sync.Mutex
+	m    atomic.Value // map[string]*structCache
+}
+
+func (gc *groupCache) Get(key string) (c *structCache, found bool) {
+	c, found = gc.m.Load().(map[string]*structCache)[key]
+	return
+}
+
+func (gc *groupCache) Set(key string, value *structCache) {
+	m := gc.m.Load().(map[string]*structCache)
+	nm := make(map[string]*structCache, len(m)+1)
+	for k, v := range m {
+		nm[k] = v
+	}
+	nm[key] = value
+	gc.m.Store(nm)
+}
+
 type tagCache struct {
 	lock sync.Mutex
 	m    atomic.Value // map[string]*cTag
@@ -80,6 +103,8 @@ type cField struct {
 	altName    string
 	namesEqual bool
 	cTags      *cTag
+	msgs       map[string]string // messages of the msg tag by validation tag, "" for any
+	variants   *fieldVariants    // registered with RegisterVariants, nil otherwise
 }
 
 type cTag struct {
@@ -96,22 +121,24 @@ type cTag struct {
 	hasParam             bool // true if parameter used eg. eq= where the equal sign has been set
 	isBlockEnd           bool // indicates the current tag represents the last validation in the block
 	runValidationWhenNil bool
+	expr                 *exprProgram // compiled param of the 'expr' and 'when' tags
+	transitions          []transition // compiled param of the 'transition' tag
 }
 
-func (v *Validate) extractStructCache(current reflect.Value, sName string) *cStruct {
-	v.structCache.lock.Lock()
-	defer v.structCache.lock.Unlock() // leave as defer! because if inner panics, it will never get unlocked otherwise!
+func (v *Validate) extractStructCache(r *registry, sc *structCache, current reflect.Value, sName string) *cStruct {
+	sc.lock.Lock()
+	defer sc.lock.Unlock() // leave as defer! because if inner panics, it will never get unlocked otherwise!
 
 	typ := current.Type()
 
 	// could have been multiple trying to access, but once first is done this ensures struct
 	// isn't parsed again.
-	cs, ok := v.structCache.Get(typ)
+	cs, ok := sc.Get(typ)
 	if ok {
 		return cs
 	}
 
-	cs = &cStruct{name: sName, fields: make([]*cField, 0), fn: v.structLevelFuncs[typ]}
+	cs = &cStruct{name: sName, fields: make([]*cField, 0), fn: r.structLevelFuncs[typ]}
 
 	numFields := current.NumField()
 
@@ -128,12 +155,16 @@ func (v *Validate) extractStructCache(current reflect.Value, sName string) *cStr
 			continue
 		}
 
-		tag = fld.Tag.Get(v.tagName)
+		tag = r.fieldTag(typ, fld, v.tagName)
 
 		if tag == skipValidationTag {
 			continue
 		}
 
+		if tag, ok = sc.groupRules(tag, fld.Tag.Get(groupsTag)); !ok {
+			continue
+		}
+
 		customName = fld.Name
 
 		if v.hasTagNameFunc {
@@ -147,7 +178,7 @@ func (v *Validate) extractStructCache(current reflect.Value, sName string) *cStr
 		// and so only struct level caching can be used instead of combined with Field tag caching
 
 		if len(tag) > 0 {
-			ctag, _ = v.parseFieldTagsRecursive(tag, fld.Name, "", false)
+			ctag = v.parseStructFieldTags(r, tag, typ, fld.Name)
 		} else {
 			// even if field doesn't have validations need cTag for traversing to potential inner/nested
 			// elements of the field.
@@ -160,29 +191,245 @@ func (v *Validate) extractStructCache(current reflect.Value, sName string) *cStr
 			altName:    customName,
 			cTags:      ctag,
 			namesEqual: fld.Name == customName,
+			msgs:       parseMessages(fld.Tag.Get(messageTag)),
+			variants:   r.variants[typ][fld.Name],
 		})
 	}
-	v.structCache.Set(typ, cs)
+	sc.Set(typ, cs)
 	return cs
 }
 
-func (v *Validate) parseFieldTagsRecursive(tag string, fieldName string, alias string, hasAlias bool) (firstCtag *cTag, current *cTag) {
+// parseMessages parses a msg tag of ';' separated messages, each for a
+// validation tag, eg. "required=Please enter your email;email=Invalid email".
+// A message without a validation tag applies to any that fails.
+func parseMessages(tag string) map[string]string {
+	if len(tag) == 0 {
+		return nil
+	}
+
+	msgs := make(map[string]string)
+	for _, s := range strings.Split(tag, messageSeparator) {
+		s = strings.TrimSpace(s)
+		if len(s) == 0 {
+			continue
+		}
+		idx := strings.Index(s, tagKeySeparator)
+		if idx == -1 || strings.ContainsAny(strings.TrimSpace(s[:idx]), " \t") {
+			// no tag, but the message may contain '='
+			msgs[""] = s
+			continue
+		}
+		msgs[strings.TrimSpace(s[:idx])] = strings.TrimSpace(s[idx+1:])
+	}
+	return msgs
+}
+
+// groupRules returns the part of a field's tag that applies to the validation
+// groups sc was built for. fieldGroups is the field's comma separated groups
+// tag; when set, the field is only validated if one of its groups is selected.
+// A tag may also be split into ';' separated sections prefixed by a group,
+// eg. "create:required;update:omitempty,min=1"; sections without a prefix
+// always apply. It returns false when the field must be skipped entirely.
+func (sc *structCache) groupRules(tag string, fieldGroups string) (string, bool) {
+
+	if len(fieldGroups) > 0 {
+		selected := false
+		for _, g := range strings.Split(fieldGroups, tagSeparator) {
+			if _, selected = sc.groups[strings.TrimSpace(g)]; selected {
+				break
+			}
+		}
+		if !selected {
+			return "", false
+		}
+	}
+
+	if !strings.Contains(tag, groupRuleSeparator) && !hasGroupPrefix(tag) {
+		return tag, true
+	}
+
+	sections := strings.Split(tag, groupRuleSeparator)
+	grouped := false
+	for _, s := range sections {
+		if hasGroupPrefix(s) {
+			grouped = true
+			break
+		}
+	}
+	if !grouped {
+		return tag, true
+	}
+
+	rules := make([]string, 0, len(sections))
+	for _, s := range sections {
+		if hasGroupPrefix(s) {
+			idx := strings.Index(s, groupPrefixSeparator)
+			if _, ok := sc.groups[s[:idx]]; !ok {
+				continue
+			}
+			s = s[idx+1:]
+		}
+		if len(s) > 0 {
+			rules = append(rules, s)
+		}
+	}
+	return strings.Join(rules, tagSeparator), true
+}
+
+// GroupSections returns the sections of a tag for every validation group, with
+// their group prefix removed, eg. "required" and "omitempty,min=1" for
+// "create:required;update:omitempty,min=1", see StructGroups. A tag without
+// groups is a single section.
+func GroupSections(tag string) []string {
+	if !strings.Contains(tag, groupRuleSeparator) && !hasGroupPrefix(tag) {
+		return []string{tag}
+	}
+
+	sections := strings.Split(tag, groupRuleSeparator)
+	rules := make([]string, 0, len(sections))
+	for _, s := range sections {
+		if hasGroupPrefix(s) {
+			s = s[strings.Index(s, groupPrefixSeparator)+1:]
+		}
+		if len(s) > 0 {
+			rules = append(rules, s)
+		}
+	}
+	return rules
+}
+
+// hasGroupPrefix reports whether a tag section starts with "<group>:".
+func hasGroupPrefix(s string) bool {
+	idx := strings.Index(s, groupPrefixSeparator)
+	if idx <= 0 {
+		return false
+	}
+	for _, r := range s[:idx] {
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseStructFieldTags parses the tag of the field fieldName of the struct typ,
+// also checking that the fields named by its expr and when params exist and
+// that its monotonic tags are used on values which can be compared.
+func (v *Validate) parseStructFieldTags(r *registry, tag string, typ reflect.Type, fieldName string) *cTag {
+	tags := parseTag(tag, fieldName)
+	ctag, _ := v.buildTagChain(r, tags, fieldName, "", false)
+	v.checkExprFields(r, tags, typ, fieldName)
+	if fld, ok := typ.FieldByName(fieldName); ok {
+		v.checkMonotonic(r, tags, fld.Type, fieldName)
+	}
+	return ctag
+}
+
+// checkExprFields panics with a TagSyntaxError if an expr or when param in
+// tags names a field which typ, the struct holding the field, does not have.
+// Fields relative to the top level struct are only known when validating.
+func (v *Validate) checkExprFields(r *registry, tags []*tagExpr, typ reflect.Type, fieldName string) {
+	for _, e := range tags {
+		if e.kind != exprTag {
+			v.checkExprFields(r, e.args, typ, fieldName)
+			continue
+		}
+
+		if tagsVal, found := r.aliases[e.name]; found && !e.hasParam {
+			v.checkExprFields(r, parseTag(tagsVal, fieldName), typ, fieldName)
+			continue
+		}
+
+		if (e.name != expressionTag && e.name != whenTag) || !e.hasParam {
+			continue
+		}
+
+		prog, err := compileExpr(unescapeParam(e.param))
+		if err != nil {
+			// reported when the tag is compiled
+			continue
+		}
+
+		for _, f := range prog.fields {
+			if !r.hasField(typ, f.ns) {
+				panic(&TagSyntaxError{
+					Field:  fieldName,
+					Tag:    e.src,
+					Column: e.pos + len(e.name) + f.pos + 2,
+					Msg:    fmt.Sprintf("invalid '%s' expression: unknown field '%s'", e.name, f.ns),
+				})
+			}
+		}
+	}
+}
+
+// hasField reports whether the namespace ns, eg. Address.City or Items[0].Name,
+// names a field of typ. Fields within interfaces or types having a custom type
+// func are only known when validating and are assumed to exist.
+func (r *registry) hasField(typ reflect.Type, ns string) bool {
+	for len(ns) > 0 {
+		for typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+
+		if _, ok := r.customFuncs[typ]; ok {
+			return true
+		}
+
+		switch typ.Kind() {
+		case reflect.Interface:
+			return true
+
+		case reflect.Struct:
+			if typ == timeType {
+				return false
+			}
+
+			name := ns
+			if idx := strings.IndexAny(ns, namespaceSeparator+leftBracket); idx != -1 {
+				name = ns[:idx]
+			}
+
+			fld, ok := typ.FieldByName(name)
+			if !ok {
+				return false
+			}
+			typ, ns = fld.Type, strings.TrimPrefix(ns[len(name):], namespaceSeparator)
+
+		case reflect.Slice, reflect.Array, reflect.Map:
+			idx := strings.Index(ns, rightBracket)
+			if !strings.HasPrefix(ns, leftBracket) || idx == -1 {
+				return false
+			}
+			typ, ns = typ.Elem(), strings.TrimPrefix(ns[idx+1:], namespaceSeparator)
+
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (v *Validate) parseFieldTagsRecursive(r *registry, tag string, fieldName string, alias string, hasAlias bool) (firstCtag *cTag, current *cTag) {
+	return v.buildTagChain(r, parseTag(tag, fieldName), fieldName, alias, hasAlias)
+}
+
+func (v *Validate) buildTagChain(r *registry, tags []*tagExpr, fieldName string, alias string, hasAlias bool) (firstCtag *cTag, current *cTag) {
 	var t string
 	noAlias := len(alias) == 0
-	tags := strings.Split(tag, tagSeparator)
 
 	for i := 0; i < len(tags); i++ {
-		t = tags[i]
+		t = tags[i].text
 		if noAlias {
 			alias = t
 		}
 
 		// check map for alias and process new tags, otherwise process as usual
-		if tagsVal, found := v.aliases[t]; found {
+		if tagsVal, found := r.aliases[t]; found && tags[i].kind == exprTag {
 			if i == 0 {
-				firstCtag, current = v.parseFieldTagsRecursive(tagsVal, fieldName, t, true)
+				firstCtag, current = v.parseFieldTagsRecursive(r, tagsVal, fieldName, t, true)
 			} else {
-				next, curr := v.parseFieldTagsRecursive(tagsVal, fieldName, t, true)
+				next, curr := v.parseFieldTagsRecursive(r, tagsVal, fieldName, t, true)
 				current.next, current = next, curr
 
 			}
@@ -200,6 +447,10 @@ func (v *Validate) parseFieldTagsRecursive(tag string, fieldName string, alias s
 			current = current.next
 		}
 
+		if tags[i].kind != exprTag {
+			t = ""
+		}
+
 		switch t {
 		case diveTag:
 			current.typeof = typeDive
@@ -216,21 +467,20 @@ func (v *Validate) parseFieldTagsRecursive(tag string, fieldName string, alias s
 
 			// need to pass along only keys tag
 			// need to increment i to skip over the keys tags
-			b := make([]byte, 0, 64)
-
 			i++
+			j := i
 
 			for ; i < len(tags); i++ {
-
-				b = append(b, tags[i]...)
-				b = append(b, ',')
-
-				if tags[i] == endKeysTag {
+				if tags[i].text == endKeysTag {
 					break
 				}
 			}
 
-			current.keys, _ = v.parseFieldTagsRecursive(string(b[:len(b)-1]), fieldName, "", false)
+			end := i + 1
+			if end > len(tags) {
+				end = len(tags)
+			}
+			current.keys, _ = v.buildTagChain(r, tags[j:end], fieldName, "", false)
 			continue
 
 		case endKeysTag:
@@ -256,16 +506,24 @@ func (v *Validate) parseFieldTagsRecursive(tag string, fieldName string, alias s
 			continue
 
 		default:
+			if !r.isSimpleTerm(tags[i]) {
+				v.compileTagTerm(r, current, tags[i], fieldName, noAlias)
+				continue
+			}
+
+			t = tags[i].text
 			if t == isdefault {
 				current.typeof = typeIsDefault
 			}
-			// if a pipe character is needed within the param you must use the utf8Pipe representation "0x7C"
-			orVals := strings.Split(t, orSeparator)
+
+			orVals := []*tagExpr{tags[i]}
+			if tags[i].kind == exprOr {
+				orVals = tags[i].args
+			}
 
 			for j := 0; j < len(orVals); j++ {
-				vals := strings.SplitN(orVals[j], tagKeySeparator, 2)
 				if noAlias {
-					alias = vals[0]
+					alias = orVals[j].name
 					current.aliasTag = alias
 				} else {
 					current.actualAliasTag = t
@@ -275,14 +533,11 @@ func (v *Validate) parseFieldTagsRecursive(tag string, fieldName string, alias s
 					current.next = &cTag{aliasTag: alias, actualAliasTag: current.actualAliasTag, hasAlias: hasAlias, hasTag: true}
 					current = current.next
 				}
-				current.hasParam = len(vals) > 1
+				current.hasParam = orVals[j].hasParam
 
-				current.tag = vals[0]
-				if len(current.tag) == 0 {
-					panic(strings.TrimSpace(fmt.Sprintf(invalidValidation, fieldName)))
-				}
+				current.tag = orVals[j].name
 
-				if wrapper, ok := v.validations[current.tag]; ok {
+				if wrapper, ok := r.validations[current.tag]; ok {
 					current.fn = wrapper.fn
 					current.runValidationWhenNil = wrapper.runValidatinOnNil
 				} else {
@@ -293,9 +548,11 @@ func (v *Validate) parseFieldTagsRecursive(tag string, fieldName string, alias s
 					current.typeof = typeOr
 				}
 
-				if len(vals) > 1 {
-					current.param = strings.Replace(strings.Replace(vals[1], utf8HexComma, ",", -1), utf8Pipe, "|", -1)
+				if orVals[j].hasParam {
+					current.param = unescapeParam(orVals[j].param)
 				}
+
+				v.compileParam(current, orVals[j], fieldName)
 			}
 			current.isBlockEnd = true
 		}
@@ -303,19 +560,114 @@ func (v *Validate) parseFieldTagsRecursive(tag string, fieldName string, alias s
 	return
 }
 
-func (v *Validate) fetchCacheTag(tag string) *cTag {
+// compileTagTerm sets up current to run a term using grouping or negation as a
+// single validation. A negated tag, eg. !contains=admin, reports the tag
+// prefixed with '!' and its param; any other term reports its source text.
+func (v *Validate) compileTagTerm(r *registry, current *cTag, e *tagExpr, fieldName string, noAlias bool) {
+	current.fn, current.runValidationWhenNil = v.compileTagExpr(r, e, fieldName)
+	current.tag = e.text
+
+	if e.kind == exprNot && e.args[0].kind == exprTag {
+		current.tag = "!" + e.args[0].name
+		current.hasParam = e.args[0].hasParam
+		current.param = unescapeParam(e.args[0].param)
+	}
+
+	if noAlias {
+		current.aliasTag = current.tag
+	} else {
+		current.actualAliasTag = e.text
+	}
+	current.isBlockEnd = true
+}
+
+// precompileType parses the tags of typ, and of the structs it contains, adding
+// them to the struct cache. An error is appended to errs for every invalid tag
+// and structs with invalid tags are not cached.
+func (v *Validate) precompileType(r *registry, typ reflect.Type, seen map[reflect.Type]struct{}, errs *PrecompileErrors) {
+
+	for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array || typ.Kind() == reflect.Map {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct || typ == timeType {
+		return
+	}
+
+	if _, ok := seen[typ]; ok {
+		return
+	}
+	seen[typ] = struct{}{}
+
+	// validated as the value returned by the custom type func
+	if _, ok := r.customFuncs[typ]; ok {
+		return
+	}
+
+	valid := true
+
+	for i := 0; i < typ.NumField(); i++ {
+
+		fld := typ.Field(i)
+
+		if !fld.Anonymous && len(fld.PkgPath) > 0 {
+			continue
+		}
+
+		tag := r.fieldTag(typ, fld, v.tagName)
+
+		if tag == skipValidationTag {
+			continue
+		}
+
+		for _, rules := range GroupSections(tag) {
+			if len(rules) == 0 {
+				continue
+			}
+			if err := v.checkTag(r, rules, typ, fld.Name); err != nil {
+				*errs = append(*errs, &FieldTagError{Type: typ, Field: fld.Name, Tag: tag, Err: err})
+				valid = false
+			}
+		}
+
+		v.precompileType(r, fld.Type, seen, errs)
+	}
+
+	if _, ok := r.structCache.Get(typ); valid && !ok {
+		v.extractStructCache(r, r.structCache, reflect.New(typ).Elem(), typ.Name())
+	}
+}
+
+// checkTag parses tag, the tag of the field fieldName of the struct typ,
+// returning the panic it would otherwise cause as an error.
+func (v *Validate) checkTag(r *registry, tag string, typ reflect.Type, fieldName string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+				return
+			}
+			err = errors.New(fmt.Sprint(r))
+		}
+	}()
+
+	v.parseStructFieldTags(r, tag, typ, fieldName)
+	return nil
+}
+
+func (v *Validate) fetchCacheTag(r *registry, tag string) *cTag {
 	// find cached tag
-	ctag, found := v.tagCache.Get(tag)
+	ctag, found := r.tagCache.Get(tag)
 	if !found {
-		v.tagCache.lock.Lock()
-		defer v.tagCache.lock.Unlock()
+		r.tagCache.lock.Lock()
+		defer r.tagCache.lock.Unlock()
 
 		// could have been multiple trying to access, but once first is done this ensures tag
 		// isn't parsed again.
-		ctag, found = v.tagCache.Get(tag)
+		ctag, found = r.tagCache.Get(tag)
 		if !found {
-			ctag, _ = v.parseFieldTagsRecursive(tag, "", "", false)
-			v.tagCache.Set(tag, ctag)
+			ctag, _ = v.parseFieldTagsRecursive(r, tag, "", "", false)
+			r.tagCache.Set(tag, ctag)
 		}
 	}
 	return ctag
@@ -0,0 +1,311 @@
+package validator
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultValueTag       = "default"
+	defaultElemSeparator  = "|"
+	invalidDefaultValue   = "validator: invalid default '%s' on field '%s': %v"
+	defaultDateTimeFormat = "2006-01-02"
+)
+
+var (
+	defaulterType       = reflect.TypeOf((*Defaulter)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// Defaulter is implemented by structs setting defaults which cannot be given
+// in tags, eg. derived from other fields. SetDefaults is called by
+// Validate.SetDefaults once the fields of the struct have their defaults.
+type Defaulter interface {
+	SetDefaults()
+}
+
+type defaultsCache struct {
+	lock sync.Mutex
+	m    atomic.Value // map[reflect.Type]*dStruct
+}
+
+func (dc *defaultsCache) Get(key reflect.Type) (c *dStruct, found bool) {
+	c, found = dc.m.Load().(map[reflect.Type]*dStruct)[key]
+	return
+}
+
+func (dc *defaultsCache) Set(key reflect.Type, value *dStruct) {
+	m := dc.m.Load().(map[reflect.Type]*dStruct)
+	nm := make(map[reflect.Type]*dStruct, len(m)+1)
+	for k, v := range m {
+		nm[k] = v
+	}
+	nm[key] = value
+	dc.m.Store(nm)
+}
+
+type dStruct struct {
+	fields    []*dField
+	defaulter bool // the struct's pointer implements Defaulter
+}
+
+type dField struct {
+	idx        int
+	hasDefault bool
+	value      reflect.Value // the parsed default, invalid for structs
+}
+
+// SetDefaults sets the zero valued exposed fields of a struct to the value of
+// their default tag, and does the same for nested structs, including those of
+// slices and arrays, whatever the struct was decoded from. s must be a pointer,
+// usually to a struct.
+//
+// Defaults are given for strings, bools, decimal numbers, time.Duration,
+// time.Time as RFC 3339 or 2006-01-02, types implementing
+// encoding.TextUnmarshaler, and slices and arrays of those, whose elements are
+// separated by '|':
+//
+//	type Config struct {
+//		Port    int           `default:"8080"`
+//		Timeout time.Duration `default:"30s"`
+//		Hosts   []string      `default:"a.example.com|b.example.com"`
+//		TLS     *TLSConfig    `default:"{}"`
+//	}
+//
+// Nil pointers with a default tag are allocated, then set to the default;
+// the default of a pointer to a struct is unused, eg. "{}", as the struct gets
+// the defaults of its fields. Pointers to a struct whose defaults are being
+// set, eg. Next *Node in Node, are left nil rather than allocated without end.
+// Structs implementing Defaulter have their SetDefaults called last.
+//
+// It returns InvalidValidationError for bad values passed in and panics if a
+// default cannot be parsed as the type of its field.
+func (v *Validate) SetDefaults(s interface{}) error {
+
+	val := reflect.ValueOf(s)
+	if val.Kind() != reflect.Ptr || val.IsNil() {
+		return &InvalidValidationError{Type: reflect.TypeOf(s)}
+	}
+
+	v.setDefaults(v.registry(), val.Elem(), nil)
+	return nil
+}
+
+// setDefaults sets the defaults of current, an addressable value, and of the
+// structs it holds. path holds the types of the structs whose defaults are
+// being set, which are not allocated again.
+func (v *Validate) setDefaults(r *registry, current reflect.Value, path []reflect.Type) {
+
+	switch current.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		// the value of an interface is only addressable through a pointer
+		if !current.IsNil() && (current.Kind() == reflect.Ptr || current.Elem().Kind() == reflect.Ptr) {
+			v.setDefaults(r, current.Elem(), path)
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < current.Len(); i++ {
+			v.setDefaults(r, current.Index(i), path)
+		}
+
+	case reflect.Struct:
+		typ := current.Type()
+		if typ == timeType {
+			return
+		}
+
+		ds, ok := r.defaultsCache.Get(typ)
+		if !ok {
+			ds = v.extractDefaultsCache(r, typ)
+		}
+
+		path = append(path, typ)
+
+		for _, f := range ds.fields {
+			fld := current.Field(f.idx)
+			if f.hasDefault && fld.IsZero() && !onPath(path, fld.Type()) {
+				setDefault(fld, f.value)
+			}
+			v.setDefaults(r, fld, path)
+		}
+
+		if ds.defaulter {
+			current.Addr().Interface().(Defaulter).SetDefaults()
+		}
+	}
+}
+
+// onPath reports whether typ, or the type it points to, is in path.
+func onPath(path []reflect.Type, typ reflect.Type) bool {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	for _, t := range path {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// setDefault sets field, which is zero, to value, allocating pointers.
+func setDefault(field reflect.Value, value reflect.Value) {
+	for field.Kind() == reflect.Ptr {
+		field.Set(reflect.New(field.Type().Elem()))
+		field = field.Elem()
+	}
+
+	if !value.IsValid() {
+		return
+	}
+
+	if value.Kind() == reflect.Slice {
+		// the fields must not share the backing array of the default
+		value = reflect.AppendSlice(reflect.MakeSlice(value.Type(), 0, value.Len()), value)
+	}
+	field.Set(value)
+}
+
+func (v *Validate) extractDefaultsCache(r *registry, typ reflect.Type) *dStruct {
+	r.defaultsCache.lock.Lock()
+	defer r.defaultsCache.lock.Unlock() // leave as defer! because if inner panics, it will never get unlocked otherwise!
+
+	// could have been multiple trying to access, but once first is done this ensures struct
+	// isn't parsed again.
+	ds, ok := r.defaultsCache.Get(typ)
+	if ok {
+		return ds
+	}
+
+	ds = &dStruct{
+		fields:    make([]*dField, 0),
+		defaulter: reflect.PtrTo(typ).Implements(defaulterType),
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+
+		fld := typ.Field(i)
+
+		if !fld.Anonymous && len(fld.PkgPath) > 0 {
+			continue
+		}
+
+		f := &dField{idx: i}
+
+		if tag, ok := fld.Tag.Lookup(defaultValueTag); ok {
+			value, err := parseDefault(fld.Type, tag)
+			if err != nil {
+				panic(fmt.Sprintf(invalidDefaultValue, tag, fld.Name, err))
+			}
+			f.hasDefault, f.value = true, value
+		}
+
+		ds.fields = append(ds.fields, f)
+	}
+
+	r.defaultsCache.Set(typ, ds)
+	return ds
+}
+
+// parseDefault parses s as a value of typ, or of the type typ points to. The
+// value is invalid for structs, which get the defaults of their fields.
+func parseDefault(typ reflect.Type, s string) (reflect.Value, error) {
+
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	value := reflect.New(typ).Elem()
+
+	switch {
+	case typ == timeDurationType:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return value, err
+		}
+		value.SetInt(int64(d))
+		return value, nil
+
+	case typ == timeType:
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			if t, err = time.Parse(defaultDateTimeFormat, s); err != nil {
+				return value, err
+			}
+		}
+		value.Set(reflect.ValueOf(t))
+		return value, nil
+
+	case reflect.PtrTo(typ).Implements(textUnmarshalerType):
+		err := value.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+		return value, err
+	}
+
+	switch typ.Kind() {
+	case reflect.String:
+		value.SetString(s)
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return value, err
+		}
+		value.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, typ.Bits())
+		if err != nil {
+			return value, err
+		}
+		value.SetInt(i)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(s, 10, typ.Bits())
+		if err != nil {
+			return value, err
+		}
+		value.SetUint(u)
+
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, typ.Bits())
+		if err != nil {
+			return value, err
+		}
+		value.SetFloat(f)
+
+	case reflect.Slice, reflect.Array:
+		var elems []string
+		if len(s) > 0 {
+			elems = strings.Split(s, defaultElemSeparator)
+		}
+
+		if typ.Kind() == reflect.Slice {
+			value = reflect.MakeSlice(typ, len(elems), len(elems))
+		} else if len(elems) > typ.Len() {
+			return value, fmt.Errorf("%d elements for an array of %d", len(elems), typ.Len())
+		}
+
+		for i, e := range elems {
+			elem, err := parseDefault(typ.Elem(), e)
+			if err != nil {
+				return value, err
+			}
+			setDefault(value.Index(i), elem)
+		}
+
+	case reflect.Struct:
+		return reflect.Value{}, nil
+
+	default:
+		return value, fmt.Errorf("unsupported type %s", typ)
+	}
+
+	return value, nil
+}
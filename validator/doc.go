@@ -142,6 +142,220 @@ so the above will become excludesall=0x7C
 		Field `validate:"excludesall=0x7C"` // GOOD! Use the UTF-8 hex representation.
 	}
 
+Parentheses group validations, so 'and' and 'or' can be combined freely, and
+a leading '!' negates a validation or group. Single quotes allow ',', '|' and
+')' within a param without the UTF-8 hex representations; the quotes are kept
+in the param. A quote opens quoted text only at the start of the param or after
+a space, so apostrophes, eg. startswith=O'Brien, need no escaping.
+
+	type Test struct {
+		Contact  string `validate:"(email|e164),max=64"`
+		Username string `validate:"required,!contains=admin"`
+		Size     string `validate:"oneof='x,small' 'x,large'"`
+	}
+
+A malformed tag panics with a TagSyntaxError giving the column of the error.
+
+Validation Groups
+
+Rules can be bound to validation groups, so one struct can serve several
+scenarios such as create and update. A groups tag restricts all of a field's
+rules to the listed groups, and ';' separated sections of a tag prefixed with
+"<group>:" only apply to that group. Rules without a group always apply.
+Grouped rules are only run by StructGroups when one of their groups is selected.
+
+	type User struct {
+		ID   int    `validate:"required" groups:"update"`
+		Name string `validate:"create:required;update:omitempty,min=1"`
+	}
+
+	err := validate.StructGroups(ctx, user, "update")
+
+
+Precompiling
+
+Tags are parsed, and panic when invalid, the first time a struct is validated.
+Precompile parses and caches the tags of the given structs, and of all structs
+they contain, up front and returns every invalid tag as an error instead:
+
+	if err := validate.Precompile(User{}, Order{}); err != nil {
+		log.Fatal(err)
+	}
+
+Maps
+
+Map validates maps such as decoded JSON using rules keyed like the data. A
+rule is a tag, or rules for the map, or every map of the slice, held by a key.
+Errors are ValidationErrors namespaced by keys and indexes, eg. items[1].sku:
+
+	err := validate.Map(data, map[string]interface{}{
+		"email": "required,email",
+		"items": validator.NestedRules{
+			Tag:   "required,min=1",
+			Rules: map[string]interface{}{"sku": "required"},
+		},
+	})
+
+Modifiers
+
+Modify sanitizes a struct before it is validated, running the modifiers of
+the mod tags of its fields, and of nested structs, in order. dive applies the
+modifiers following it to the elements of slices and maps:
+
+	type Signup struct {
+		Email string   `mod:"trim,lower" validate:"required,email"`
+		Tags  []string `mod:"dive,collapse,snake"`
+	}
+
+	err := validate.Modify(&signup)
+
+The baked in modifiers are trim, ltrim, rtrim, lower, upper, title, snake,
+camel, collapse (whitespace), strip_html and truncate=<runes>, which leave
+values other than strings unchanged. RegisterModifier adds custom modifiers.
+
+Defaults
+
+SetDefaults sets zero valued fields to their default tag, recursively, whether
+a struct was decoded from JSON, YAML, the environment or built in code.
+Elements of slice defaults are separated by '|', nil pointers with a default
+are allocated, and structs implementing Defaulter set dynamic defaults:
+
+	type Config struct {
+		Port    int           `default:"8080"`
+		Timeout time.Duration `default:"30s"`
+		Hosts   []string      `default:"a.example.com|b.example.com"`
+		TLS     *TLSConfig    `default:"{}"`
+	}
+
+	err := validate.SetDefaults(&cfg)
+
+Updates
+
+StructUpdate validates the incoming value of a struct against its stored
+value, giving the immutable, transition and monotonic tags access to the old
+value of the field, found by its namespace. Errors are reported under the
+namespace of the incoming value, as with StructCtx:
+
+	type Post struct {
+		CreatedBy string `validate:"required,immutable"`
+		Status    string `validate:"transition=draft>published|published>archived"`
+		Revision  int    `validate:"monotonic"`
+	}
+
+	err := validate.StructUpdate(ctx, stored, incoming)
+
+VarWithValue passes the other value as the old one, eg.
+validate.VarWithValue(newStatus, oldStatus, "transition=draft>published").
+These tags pass when there is no old value, eg. when validating with Struct.
+
+Variants
+
+RegisterVariants validates an interface field holding one of several structs,
+chosen by the value of a discriminator field, as the variant it selects:
+
+	type Order struct {
+		Type    string      `validate:"oneof=card bank"`
+		Payment interface{} `validate:"required"`
+	}
+
+	validate.RegisterVariants(Order{}, "Payment", "Type", map[string]interface{}{
+		"card": CardPayment{},
+		"bank": BankPayment{},
+	})
+
+Errors of the variant are reported under the namespace of the field, eg.
+Order.Payment.Number. Maps, such as decoded JSON objects, are decoded into the
+variant before being validated, and a field holding anything other than its
+variant fails the variant tag.
+
+Custom Messages
+
+The msg tag gives a field its own messages, returned by FieldError.Translate
+instead of the translation of the failed tag. Messages are ';' separated and
+prefixed by the tag they are for, or apply to any tag when not prefixed. A
+message is first looked up as a key of the translator, given the field, param
+and value as {0}, {1} and {2}, and {field}, {param} and {value} are replaced:
+
+	type Signup struct {
+		Email string `validate:"required,email" msg:"required=Please enter your work email;email=Invalid address {value}"`
+		Name  string `validate:"required,max=64" msg:"signup_name"`
+	}
+
+Field Labels
+
+Translations name fields by Field(), the Go or tag name function name. The
+label tag, and labels registered per locale with RegisterFieldLabels, replace
+it when translating, as well as the field named by eqfield and similar tags:
+
+	validate.RegisterFieldLabels(zhTrans, User{}, map[string]string{
+		"Email":    "邮箱",
+		"Password": "密码",
+	})
+
+Translation Catalogs
+
+Package translations/catalog registers translations from JSON or YAML files,
+eg. embedded with embed.FS, resolving tags missing for a locale through a
+fallback chain such as zh_Hant_TW, zh_tw, zh and en:
+
+	//go:embed catalogs
+	var catalogs embed.FS
+
+	fsys, _ := fs.Sub(catalogs, "catalogs")
+	err := catalog.Register(validate, twTrans, fsys)
+
+Error Trees
+
+ValidationErrors.Tree arranges errors by field, index and map key, mirroring
+the validated struct, and For returns the errors of a part of it:
+
+	errs := err.(validator.ValidationErrors)
+	tree := errs.Tree() // tree.Children["Addresses"].Children["0"].Children["City"]
+	address := errs.For("Addresses[0]")
+
+Problem Details
+
+Package problem renders ValidationErrors as RFC 7807 application/problem+json
+responses, locating each field by a JSON pointer derived from json tags:
+
+	problem.New(errs, req, &problem.Options{Translator: trans}).Write(w)
+
+Rules Without Tags
+
+Structs which cannot be given tags, such as generated or third party types,
+can have rules registered for their fields instead. A rule replaces the tag
+of its field, and errors are reported as if it were the tag:
+
+	err := validate.RegisterStructRules(pb.User{}, map[string]string{
+		"Email": "required,email",
+		"Items": "dive,required",
+	})
+
+LoadStructRules registers the same rules from YAML or JSON, keyed by type
+and field name.
+
+Fail Fast
+
+By default every field is validated and every error returned. When only
+whether a value is valid matters, SetFailFast stops at the first error, and
+SetMaxErrors after a given number of errors, skipping the remaining fields,
+dive elements and struct level validations:
+
+	validate.SetFailFast(true)
+
+	err := validate.Struct(order) // at most one FieldError
+
+Cancellation
+
+StructCtx, VarCtx and the other Ctx methods check the context every 64
+fields and dive elements, see SetContextCheckInterval, and stop once it is
+canceled or its deadline exceeded, returning a *CanceledError holding the
+errors found so far:
+
+	err := validate.StructCtx(req.Context(), batch)
+	if errors.Is(err, context.Canceled) {
+		return
+	}
 
 Baked In Validators and Tags
 
@@ -341,6 +555,81 @@ Example:
 	// require the field if the Field1 and Field2 is not present:
 	Usage: required_without_all=Field1 Field2
 
+Required When
+
+The field under validation must be present and not empty only if the
+expression in the param evaluates to true. See Expression for the syntax.
+For strings ensures value is not "". For slices, maps, pointers,
+interfaces, channels and functions ensures the value is not nil.
+
+	Usage: when
+
+Examples:
+
+	// require the field for companies outside of China:
+	Usage: when=Type == 'company' && Country != 'CN'
+
+Expression
+
+This validates that the expression in the param evaluates to true. The
+expression is compiled once, when the tag is first used, and supports
+comparisons (==, !=, <, <=, >, >=), arithmetic (+, -, *, /, %), &&, ||, !,
+len() and in, along with number, 'string', true, false and nil literals.
+
+Identifiers refer to fields of the parent struct and may be namespaces such as
+Address.City or Items[0].Name; a leading $ refers to the top level struct
+instead, eg. $.Order.Total, and 'this' is the field under validation. Fields
+that cannot be reached, eg. through a nil pointer, are nil. A field which the
+parent struct does not have panics with a TagSyntaxError when the struct is
+first validated, or is reported by Precompile.
+
+'in' tests a value against a parenthesized list, a substring of a string, an
+element of a slice or array, or a key of a map. Expressions may contain '||',
+and ',' within parentheses, without being escaped. An expression that cannot
+be evaluated, such as comparing a string to a number, fails the validation.
+
+	Usage: expr
+
+Examples:
+
+	// the discount may be at most half the price:
+	Usage: expr=this <= Price * 0.5
+
+	// a US zip code must have 5 characters:
+	Usage: expr=Country != 'US' || len(this) == 5
+
+	// only known currencies:
+	Usage: expr=this in ('EUR', 'USD')
+
+Immutable
+
+This validates that the value has not changed from the old value given to
+StructUpdate or VarWithValue. Nil pointers are equal to each other only.
+
+	Usage: immutable
+
+Transition
+
+This validates that the value has not changed from the old value given to
+StructUpdate or VarWithValue, or has changed as one of the '|' separated
+transitions of the param allows. A transition is written old>new, where '*'
+matches any value, and values are compared formatted with fmt.Sprint. The param
+may contain '|' without being escaped, so transition cannot be used in an OR.
+Any value is allowed when the old one is nil. A transition without '>' panics
+with a TagSyntaxError when the tag is parsed, or is reported by Precompile.
+
+	Usage: transition=draft>published|published>archived|*>deleted
+
+Monotonic
+
+This validates that the value has not decreased from the old value given to
+StructUpdate or VarWithValue. Numbers and time.Time are compared by value,
+slices, arrays and maps by their number of items. Used on a struct field of any
+other type, it panics with a TagSyntaxError when the struct is first validated,
+or is reported by Precompile; Var and VarWithValue panic when validating.
+
+	Usage: monotonic
+
 Is Default
 
 This validates that the value is the default value and is almost the
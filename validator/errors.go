@@ -2,6 +2,7 @@ package validator
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -32,6 +33,54 @@ func (e *InvalidValidationError) Error() string {
 	return "validator: (nil " + e.Type.String() + ")"
 }
 
+// FieldTagError describes an invalid validation tag found by Precompile.
+type FieldTagError struct {
+	Type  reflect.Type // the struct declaring the field
+	Field string
+	Tag   string
+	Err   error // a *TagSyntaxError, or the error the tag panics with when used
+}
+
+// Error returns the FieldTagError message
+func (e *FieldTagError) Error() string {
+	return fmt.Sprintf("validator: %s.%s: %s", e.Type, e.Field, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *FieldTagError) Unwrap() error {
+	return e.Err
+}
+
+// PrecompileErrors is returned by Precompile and holds a FieldTagError for
+// every invalid tag found.
+type PrecompileErrors []error
+
+// Error returns the messages of all errors, one per line.
+func (pe PrecompileErrors) Error() string {
+	msgs := make([]string, len(pe))
+	for i, err := range pe {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// CanceledError is returned when the context passed to a validation is canceled
+// or its deadline exceeded before the validation completes.
+type CanceledError struct {
+	Err    error            // context.Canceled or context.DeadlineExceeded
+	Errors ValidationErrors // errors found before the validation stopped
+}
+
+// Error returns the CanceledError message
+func (e *CanceledError) Error() string {
+	return "validator: validation stopped: " + e.Err.Error()
+}
+
+// Unwrap returns the context error
+func (e *CanceledError) Unwrap() error {
+	return e.Err
+}
+
 // ValidationErrors is an array of FieldError's
 // for use in custom error messages post validation.
 type ValidationErrors []FieldError
@@ -79,6 +128,134 @@ func (ve ValidationErrors) Translate(ut ut.Translator) ValidationErrorsTranslati
 	return trans
 }
 
+// ErrorTree is a node of the tree of ValidationErrors returned by Tree,
+// holding the errors of a field and the nodes of the fields, elements or map
+// values it contains.
+type ErrorTree struct {
+	Errors   ValidationErrors
+	Children map[string]*ErrorTree
+}
+
+// Tree arranges the errors in a tree mirroring the structure of the validated
+// value. The root stands for the top level struct, and its children are keyed
+// by field name, or by index or map key for the elements of a slice, array or
+// map, following the namespaces of the errors, eg. "User.Addresses[0].City"
+// is found at Children["Addresses"].Children["0"].Children["City"].
+func (ve ValidationErrors) Tree() *ErrorTree {
+
+	root := new(ErrorTree)
+
+	for _, fe := range ve {
+
+		node := root
+
+		for _, key := range namespaceKeys(relNamespace(fe)) {
+			if node.Children == nil {
+				node.Children = make(map[string]*ErrorTree)
+			}
+			child, ok := node.Children[key]
+			if !ok {
+				child = new(ErrorTree)
+				node.Children[key] = child
+			}
+			node = child
+		}
+
+		node.Errors = append(node.Errors, fe)
+	}
+
+	return root
+}
+
+// For returns the errors of the field at path and of the fields it contains,
+// eg. errs.For("Addresses[0]") returns the errors of the first address. Like
+// the nodes of Tree, path is relative to the top level struct.
+func (ve ValidationErrors) For(path string) ValidationErrors {
+
+	var errs ValidationErrors
+
+	for _, fe := range ve {
+		ns := relNamespace(fe)
+		if !strings.HasPrefix(ns, path) {
+			continue
+		}
+		if rest := ns[len(path):]; len(rest) == 0 || len(path) == 0 || rest[0] == '.' || rest[0] == '[' {
+			errs = append(errs, fe)
+		}
+	}
+
+	return errs
+}
+
+// MarshalJSON encodes the node as its errors, each with its tag, param and
+// message, along with its children.
+func (t *ErrorTree) MarshalJSON() ([]byte, error) {
+
+	type jsonError struct {
+		Tag     string `json:"tag"`
+		Param   string `json:"param,omitempty"`
+		Message string `json:"message"`
+	}
+
+	node := struct {
+		Errors   []jsonError           `json:"errors,omitempty"`
+		Children map[string]*ErrorTree `json:"children,omitempty"`
+	}{
+		Children: t.Children,
+	}
+
+	for _, fe := range t.Errors {
+		node.Errors = append(node.Errors, jsonError{Tag: fe.Tag(), Param: fe.Param(), Message: fe.Error()})
+	}
+
+	return json.Marshal(node)
+}
+
+// relNamespace returns the namespace of fe without the name of the top level
+// struct.
+func relNamespace(fe FieldError) string {
+	ns := fe.Namespace()
+	if e, ok := fe.(*fieldError); ok && int(e.topLen) <= len(ns) {
+		ns = ns[e.topLen:]
+	}
+	return ns
+}
+
+// namespaceKeys splits a namespace into field names, indexes and map keys, eg.
+// "Addresses[0].City" into "Addresses", "0" and "City".
+func namespaceKeys(ns string) []string {
+
+	var keys []string
+
+	for len(ns) > 0 {
+		switch ns[0] {
+		case '.':
+			ns = ns[1:]
+
+		case '[':
+			end := strings.IndexByte(ns, ']')
+			if end == -1 {
+				end = len(ns)
+			}
+			keys = append(keys, ns[1:end])
+			ns = ns[end:]
+			if len(ns) > 0 {
+				ns = ns[1:]
+			}
+
+		default:
+			end := strings.IndexAny(ns, ".[")
+			if end == -1 {
+				end = len(ns)
+			}
+			keys = append(keys, ns[:end])
+			ns = ns[end:]
+		}
+	}
+
+	return keys
+}
+
 // FieldError contains all functions to get error details
 type FieldError interface {
 
@@ -152,6 +329,9 @@ type FieldError interface {
 	// Translate returns the FieldError's translated error
 	// from the provided 'ut.Translator' and registered 'TranslationFunc'
 	//
+	// NOTE: the message given for the failed tag by the field's msg tag, if
+	// any, takes precedence.
+	//
 	// NOTE: if no registered translator can be found it returns the same as
 	// calling fe.Error()
 	Translate(ut ut.Translator) string
@@ -175,12 +355,41 @@ type fieldError struct {
 	structNs       string
 	fieldLen       uint8
 	structfieldLen uint8
+	topLen         uint8             // length of the top level struct name and '.' prefixing ns and structNs
+	msgs           map[string]string // messages of the field's msg tag
+	parent         reflect.Type      // struct declaring the field, for labels
+	label          string            // replaces Field() when translating
+	paramLabel     string            // replaces Param() when translating
 	value          interface{}
 	param          string
 	kind           reflect.Kind
 	typ            reflect.Type
 }
 
+// NewFieldError returns a FieldError for a failure detected outside of the
+// validator, such as a value that could not be converted while binding a
+// request, so it can be reported and translated along with validation errors.
+// ns and structNs are '.' separated namespaces ending with the field name;
+// the value's Kind and Type are taken from typ.
+func (v *Validate) NewFieldError(tag, ns, structNs string, value interface{}, param string, typ reflect.Type) FieldError {
+	fe := &fieldError{
+		v:              v,
+		tag:            tag,
+		actualTag:      tag,
+		ns:             ns,
+		structNs:       structNs,
+		fieldLen:       uint8(len(ns) - strings.LastIndex(ns, ".") - 1),
+		structfieldLen: uint8(len(structNs) - strings.LastIndex(structNs, ".") - 1),
+		value:          value,
+		param:          param,
+		typ:            typ,
+	}
+	if typ != nil {
+		fe.kind = typ.Kind()
+	}
+	return fe
+}
+
 // Tag returns the validation tag that failed.
 func (fe *fieldError) Tag() string {
 	return fe.tag
@@ -208,6 +417,10 @@ func (fe *fieldError) StructNamespace() string {
 // field's actual name.
 func (fe *fieldError) Field() string {
 
+	if len(fe.label) > 0 {
+		return fe.label
+	}
+
 	return fe.ns[len(fe.ns)-int(fe.fieldLen):]
 	// // return fe.field
 	// fld := fe.ns[len(fe.ns)-int(fe.fieldLen):]
@@ -236,6 +449,9 @@ func (fe *fieldError) Value() interface{} {
 // Param returns the param value, in string form for comparison; this will
 // also help with generating an error message
 func (fe *fieldError) Param() string {
+	if len(fe.paramLabel) > 0 {
+		return fe.paramLabel
+	}
 	return fe.param
 }
 
@@ -256,11 +472,22 @@ func (fe *fieldError) Error() string {
 
 // Translate returns the FieldError's translated error
 // from the provided 'ut.Translator' and registered 'TranslationFunc'
+// or, first, the message given for the failed tag by the field's msg tag
 //
 // NOTE: if no registered translation can be found, it returns the original
 // untranslated error message.
 func (fe *fieldError) Translate(ut ut.Translator) string {
 
+	fe = fe.labeled(ut)
+
+	if msg, ok := fe.message(ut); ok {
+		return msg
+	}
+
+	if fe.v == nil {
+		return fe.Error()
+	}
+
 	m, ok := fe.v.transTagFunc[ut]
 	if !ok {
 		return fe.Error()
@@ -273,3 +500,36 @@ func (fe *fieldError) Translate(ut ut.Translator) string {
 
 	return fn(ut, fe)
 }
+
+// message returns the message of the field's msg tag for the failed tag. The
+// message is used as a key to look up a translation first, which is given the
+// field name, param and value as {0}, {1} and {2}. The {field}, {param} and
+// {value} placeholders of the message or translation are then replaced.
+func (fe *fieldError) message(ut ut.Translator) (string, bool) {
+
+	if len(fe.msgs) == 0 {
+		return "", false
+	}
+
+	msg, ok := fe.msgs[fe.tag]
+	if !ok {
+		if msg, ok = fe.msgs[fe.actualTag]; !ok {
+			if msg, ok = fe.msgs[""]; !ok {
+				return "", false
+			}
+		}
+	}
+
+	value := fmt.Sprint(fe.value)
+
+	if ut != nil {
+		if t, err := ut.T(msg, fe.Field(), fe.Param(), value); err == nil {
+			msg = t
+		}
+	}
+
+	if strings.Contains(msg, "{") {
+		msg = strings.NewReplacer("{field}", fe.Field(), "{param}", fe.Param(), "{value}", value).Replace(msg)
+	}
+	return msg, true
+}
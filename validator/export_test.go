@@ -0,0 +1,22 @@
+package validator
+
+import ut "github.com/go-playground/universal-translator"
+
+// BakedInTags returns the tags of the baked in validators and aliases, and
+// the tags of the errors reported without a validation func.
+func BakedInTags() []string {
+	tags := make([]string, 0, len(bakedInValidators)+len(bakedInAliases)+2)
+	for tag := range bakedInValidators {
+		tags = append(tags, tag)
+	}
+	for tag := range bakedInAliases {
+		tags = append(tags, tag)
+	}
+	return append(tags, mapTag, variantTag)
+}
+
+// HasTranslation reports whether a translation func is registered for tag.
+func HasTranslation(v *Validate, trans ut.Translator, tag string) bool {
+	_, ok := v.transTagFunc[trans][tag]
+	return ok
+}
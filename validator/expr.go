@@ -0,0 +1,802 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// exprProgram is the compiled param of the expr and when tags. It is built
+// once, when the tag is parsed, and stored on the cTag.
+//
+// The expression language is deliberately small and has no side effects:
+//
+//	or      = and { "||" and }
+//	and     = compare { "&&" compare }
+//	compare = sum [ ( "==" | "!=" | "<" | "<=" | ">" | ">=" ) sum | "in" ( list | sum ) ]
+//	sum     = product { ( "+" | "-" ) product }
+//	product = unary { ( "*" | "/" | "%" ) unary }
+//	unary   = ( "!" | "-" ) unary | primary
+//	primary = number | 'string' | true | false | nil | this | field | "$" [ "." field ]
+//	          | "len" "(" or ")" | "(" or ")"
+//	list    = "(" or { "," or } ")"
+//
+// A field is a namespace relative to the parent struct, eg. Address.City or
+// Items[0].Name; a leading $ makes it relative to the top level struct and
+// 'this' is the field under validation.
+type exprProgram struct {
+	src    string
+	root   exprNode
+	fields []*exprField // fields relative to the parent struct
+}
+
+type exprNode interface {
+	eval(fl FieldLevel) (exprValue, error)
+}
+
+type exprValueKind uint8
+
+const (
+	exprNil exprValueKind = iota
+	exprBool
+	exprInt
+	exprFloat
+	exprString
+	exprTime
+	exprOther
+)
+
+// exprValue is the result of evaluating an expression node. Slices, maps and
+// structs other than time.Time are kept as exprOther for len() and in.
+type exprValue struct {
+	kind exprValueKind
+	b    bool
+	i    int64
+	f    float64
+	s    string
+	t    time.Time
+	rv   reflect.Value
+}
+
+var errExprNotBool = errors.New("expression does not evaluate to a bool")
+
+// exprSyntaxError is returned by compileExpr, pos being the offset of the
+// error within the expression.
+type exprSyntaxError struct {
+	pos int
+	msg string
+}
+
+func (e *exprSyntaxError) Error() string {
+	return fmt.Sprintf("%s at offset %d", e.msg, e.pos)
+}
+
+// compileExpr parses src into an exprProgram.
+func compileExpr(src string) (prog *exprProgram, err error) {
+	p := &exprParser{lex: exprLexer{src: src}}
+
+	defer func() {
+		if r := recover(); r != nil {
+			se, ok := r.(*exprSyntaxError)
+			if !ok {
+				panic(r)
+			}
+			prog, err = nil, se
+		}
+	}()
+
+	p.next()
+	if p.tok.kind == tokEOF {
+		p.fail(p.tok.pos, "missing expression")
+	}
+	root := p.parseOr()
+	if p.tok.kind != tokEOF {
+		p.fail(p.tok.pos, "unexpected %q", p.tok.text)
+	}
+	return &exprProgram{src: src, root: root, fields: p.fields}, nil
+}
+
+// eval evaluates the program against the field under validation. Anything
+// other than a bool result is an error.
+func (p *exprProgram) eval(fl FieldLevel) (bool, error) {
+	val, err := p.root.eval(fl)
+	if err != nil {
+		return false, err
+	}
+	if val.kind != exprBool {
+		return false, errExprNotBool
+	}
+	return val.b, nil
+}
+
+// exprProgramOf returns the compiled expression of the current tag.
+func exprProgramOf(fl FieldLevel) *exprProgram {
+	if vd, ok := fl.(*validate); ok && vd.ct != nil && vd.ct.expr != nil {
+		return vd.ct.expr
+	}
+	prog, err := compileExpr(fl.Param())
+	if err != nil {
+		panic(fmt.Sprintf("Invalid expression '%s' on field '%s': %s", fl.Param(), fl.FieldName(), err))
+	}
+	return prog
+}
+
+// lexer
+
+type exprTokenKind uint8
+
+const (
+	tokEOF exprTokenKind = iota
+	tokNumber
+	tokString
+	tokIdent
+	tokRoot
+	tokOp
+)
+
+type exprToken struct {
+	kind exprTokenKind
+	text string
+	pos  int
+}
+
+type exprLexer struct {
+	src string
+	pos int
+}
+
+func (l *exprLexer) next() (exprToken, *exprSyntaxError) {
+	for l.pos < len(l.src) && (l.src[l.pos] == ' ' || l.src[l.pos] == '\t') {
+		l.pos++
+	}
+
+	start := l.pos
+	if l.pos == len(l.src) {
+		return exprToken{kind: tokEOF, pos: start}, nil
+	}
+
+	c := l.src[l.pos]
+	switch {
+	case isDigit(c):
+		for l.pos < len(l.src) && (isDigit(l.src[l.pos]) || l.src[l.pos] == '.') {
+			l.pos++
+		}
+		return exprToken{kind: tokNumber, text: l.src[start:l.pos], pos: start}, nil
+
+	case c == '\'':
+		idx := strings.IndexByte(l.src[l.pos+1:], '\'')
+		if idx == -1 {
+			return exprToken{}, &exprSyntaxError{pos: start, msg: "unterminated string"}
+		}
+		l.pos += idx + 2
+		return exprToken{kind: tokString, text: l.src[start+1 : l.pos-1], pos: start}, nil
+
+	case c == '$':
+		l.pos++
+		if l.pos < len(l.src) && l.src[l.pos] == '.' {
+			l.pos++
+			if l.pos == len(l.src) || !isIdentStart(l.src[l.pos]) {
+				return exprToken{}, &exprSyntaxError{pos: l.pos, msg: "missing field name"}
+			}
+			if err := l.scanPath(); err != nil {
+				return exprToken{}, err
+			}
+			return exprToken{kind: tokRoot, text: l.src[start+2 : l.pos], pos: start}, nil
+		}
+		return exprToken{kind: tokRoot, pos: start}, nil
+
+	case isIdentStart(c):
+		if err := l.scanPath(); err != nil {
+			return exprToken{}, err
+		}
+		return exprToken{kind: tokIdent, text: l.src[start:l.pos], pos: start}, nil
+	}
+
+	if l.pos+1 < len(l.src) {
+		switch op := l.src[l.pos : l.pos+2]; op {
+		case "==", "!=", "<=", ">=", "&&", "||":
+			l.pos += 2
+			return exprToken{kind: tokOp, text: op, pos: start}, nil
+		}
+	}
+
+	if strings.IndexByte("<>!+-*/%(),", c) != -1 {
+		l.pos++
+		return exprToken{kind: tokOp, text: l.src[start:l.pos], pos: start}, nil
+	}
+
+	if c == '=' {
+		return exprToken{}, &exprSyntaxError{pos: start, msg: "unexpected '=', use '==' to compare"}
+	}
+	return exprToken{}, &exprSyntaxError{pos: start, msg: fmt.Sprintf("unexpected %q", c)}
+}
+
+// scanPath scans a field namespace such as Inner.Items[0].Name.
+func (l *exprLexer) scanPath() *exprSyntaxError {
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case isIdentStart(c) || isDigit(c):
+			l.pos++
+		case c == '.' && l.pos+1 < len(l.src) && isIdentStart(l.src[l.pos+1]):
+			l.pos++
+		case c == '[':
+			idx := strings.IndexByte(l.src[l.pos:], ']')
+			if idx == -1 {
+				return &exprSyntaxError{pos: l.pos, msg: "missing closing ']'"}
+			}
+			l.pos += idx + 1
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// parser
+
+type exprParser struct {
+	lex    exprLexer
+	tok    exprToken
+	fields []*exprField
+}
+
+func (p *exprParser) fail(pos int, format string, args ...interface{}) {
+	panic(&exprSyntaxError{pos: pos, msg: fmt.Sprintf(format, args...)})
+}
+
+func (p *exprParser) next() {
+	tok, err := p.lex.next()
+	if err != nil {
+		panic(err)
+	}
+	p.tok = tok
+}
+
+func (p *exprParser) isOp(ops ...string) bool {
+	if p.tok.kind != tokOp {
+		return false
+	}
+	for _, op := range ops {
+		if p.tok.text == op {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *exprParser) expect(op string) {
+	if !p.isOp(op) {
+		if p.tok.kind == tokEOF {
+			p.fail(p.tok.pos, "missing '%s'", op)
+		}
+		p.fail(p.tok.pos, "expected '%s', found %q", op, p.tok.text)
+	}
+	p.next()
+}
+
+func (p *exprParser) parseOr() exprNode {
+	n := p.parseAnd()
+	for p.isOp("||") {
+		p.next()
+		n = &exprLogical{or: true, x: n, y: p.parseAnd()}
+	}
+	return n
+}
+
+func (p *exprParser) parseAnd() exprNode {
+	n := p.parseCompare()
+	for p.isOp("&&") {
+		p.next()
+		n = &exprLogical{x: n, y: p.parseCompare()}
+	}
+	return n
+}
+
+func (p *exprParser) parseCompare() exprNode {
+	n := p.parseSum()
+
+	if p.tok.kind == tokIdent && p.tok.text == "in" {
+		p.next()
+		if !p.isOp("(") {
+			return &exprIn{x: n, y: p.parseSum()}
+		}
+		p.next()
+		in := &exprIn{x: n}
+		for {
+			in.list = append(in.list, p.parseOr())
+			if !p.isOp(",") {
+				break
+			}
+			p.next()
+		}
+		p.expect(")")
+		return in
+	}
+
+	if p.isOp("==", "!=", "<", "<=", ">", ">=") {
+		op := p.tok.text
+		p.next()
+		return &exprBinary{op: op, x: n, y: p.parseSum()}
+	}
+	return n
+}
+
+func (p *exprParser) parseSum() exprNode {
+	n := p.parseProduct()
+	for p.isOp("+", "-") {
+		op := p.tok.text
+		p.next()
+		n = &exprBinary{op: op, x: n, y: p.parseProduct()}
+	}
+	return n
+}
+
+func (p *exprParser) parseProduct() exprNode {
+	n := p.parseUnary()
+	for p.isOp("*", "/", "%") {
+		op := p.tok.text
+		p.next()
+		n = &exprBinary{op: op, x: n, y: p.parseUnary()}
+	}
+	return n
+}
+
+func (p *exprParser) parseUnary() exprNode {
+	if p.isOp("!", "-") {
+		op := p.tok.text
+		p.next()
+		return &exprUnary{op: op, x: p.parseUnary()}
+	}
+	return p.parsePrimary()
+}
+
+func (p *exprParser) parsePrimary() exprNode {
+	tok := p.tok
+
+	switch tok.kind {
+	case tokEOF:
+		p.fail(tok.pos, "missing operand")
+
+	case tokNumber:
+		p.next()
+		if i, err := strconv.ParseInt(tok.text, 10, 64); err == nil {
+			return &exprLiteral{val: exprValue{kind: exprInt, i: i}}
+		}
+		f, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			p.fail(tok.pos, "invalid number %q", tok.text)
+		}
+		return &exprLiteral{val: exprValue{kind: exprFloat, f: f}}
+
+	case tokString:
+		p.next()
+		return &exprLiteral{val: exprValue{kind: exprString, s: tok.text}}
+
+	case tokRoot:
+		p.next()
+		return &exprField{top: true, ns: tok.text}
+
+	case tokIdent:
+		p.next()
+		switch tok.text {
+		case "true", "false":
+			return &exprLiteral{val: exprValue{kind: exprBool, b: tok.text == "true"}}
+		case "nil":
+			return &exprLiteral{}
+		case "this":
+			return &exprField{this: true}
+		case "in":
+			p.fail(tok.pos, "missing operand before 'in'")
+		case "len":
+			p.expect("(")
+			n := &exprLen{x: p.parseOr()}
+			p.expect(")")
+			return n
+		}
+		n := &exprField{ns: tok.text, pos: tok.pos}
+		p.fields = append(p.fields, n)
+		return n
+
+	case tokOp:
+		if tok.text == "(" {
+			p.next()
+			n := p.parseOr()
+			p.expect(")")
+			return n
+		}
+	}
+
+	p.fail(tok.pos, "unexpected %q", tok.text)
+	return nil
+}
+
+// nodes
+
+type exprLiteral struct {
+	val exprValue
+}
+
+func (n *exprLiteral) eval(FieldLevel) (exprValue, error) {
+	return n.val, nil
+}
+
+type exprField struct {
+	ns   string
+	pos  int
+	top  bool
+	this bool
+}
+
+func (n *exprField) eval(fl FieldLevel) (exprValue, error) {
+	var current reflect.Value
+	var kind reflect.Kind
+
+	switch {
+	case n.this:
+		current, kind, _ = fl.ExtractType(fl.Field())
+	case n.top:
+		current, kind, _, _ = fl.GetStructFieldOKAdvanced2(fl.Top(), n.ns)
+	default:
+		current, kind, _, _ = fl.GetStructFieldOKAdvanced2(fl.Parent(), n.ns)
+	}
+	return newExprValue(current, kind), nil
+}
+
+type exprLen struct {
+	x exprNode
+}
+
+func (n *exprLen) eval(fl FieldLevel) (exprValue, error) {
+	x, err := n.x.eval(fl)
+	if err != nil {
+		return x, err
+	}
+
+	switch x.kind {
+	case exprNil:
+		return exprValue{kind: exprInt}, nil
+	case exprString:
+		return exprValue{kind: exprInt, i: int64(utf8.RuneCountInString(x.s))}, nil
+	case exprOther:
+		switch x.rv.Kind() {
+		case reflect.Slice, reflect.Map, reflect.Array, reflect.Chan:
+			return exprValue{kind: exprInt, i: int64(x.rv.Len())}, nil
+		}
+	}
+	return exprValue{}, errors.New("len() of a value without length")
+}
+
+// exprIn is either x in (list...) or x in y.
+type exprIn struct {
+	x    exprNode
+	list []exprNode
+	y    exprNode
+}
+
+func (n *exprIn) eval(fl FieldLevel) (exprValue, error) {
+	x, err := n.x.eval(fl)
+	if err != nil {
+		return x, err
+	}
+
+	if n.y == nil {
+		for _, item := range n.list {
+			y, err := item.eval(fl)
+			if err != nil {
+				return y, err
+			}
+			if eq, err := exprEqual(x, y); err != nil || eq {
+				return exprValue{kind: exprBool, b: eq}, err
+			}
+		}
+		return exprValue{kind: exprBool}, nil
+	}
+
+	y, err := n.y.eval(fl)
+	if err != nil {
+		return y, err
+	}
+
+	switch y.kind {
+	case exprNil:
+		return exprValue{kind: exprBool}, nil
+
+	case exprString:
+		if x.kind != exprString {
+			return exprValue{}, errors.New("'in' a string requires a string")
+		}
+		return exprValue{kind: exprBool, b: strings.Contains(y.s, x.s)}, nil
+
+	case exprOther:
+		switch y.rv.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < y.rv.Len(); i++ {
+				if eq, err := exprEqual(x, newExprValueOf(fl, y.rv.Index(i))); err != nil || eq {
+					return exprValue{kind: exprBool, b: eq}, err
+				}
+			}
+			return exprValue{kind: exprBool}, nil
+
+		case reflect.Map:
+			iter := y.rv.MapRange()
+			for iter.Next() {
+				if eq, err := exprEqual(x, newExprValueOf(fl, iter.Key())); err != nil || eq {
+					return exprValue{kind: exprBool, b: eq}, err
+				}
+			}
+			return exprValue{kind: exprBool}, nil
+		}
+	}
+	return exprValue{}, errors.New("'in' requires a list, string, slice, array or map")
+}
+
+type exprLogical struct {
+	or bool
+	x  exprNode
+	y  exprNode
+}
+
+func (n *exprLogical) eval(fl FieldLevel) (exprValue, error) {
+	for _, operand := range []exprNode{n.x, n.y} {
+		val, err := operand.eval(fl)
+		if err != nil {
+			return val, err
+		}
+		if val.kind != exprBool {
+			return exprValue{}, errExprNotBool
+		}
+		if val.b == n.or {
+			return val, nil
+		}
+	}
+	return exprValue{kind: exprBool, b: !n.or}, nil
+}
+
+type exprUnary struct {
+	op string
+	x  exprNode
+}
+
+func (n *exprUnary) eval(fl FieldLevel) (exprValue, error) {
+	x, err := n.x.eval(fl)
+	if err != nil {
+		return x, err
+	}
+
+	switch {
+	case n.op == "!" && x.kind == exprBool:
+		x.b = !x.b
+		return x, nil
+	case n.op == "-" && x.kind == exprInt:
+		x.i = -x.i
+		return x, nil
+	case n.op == "-" && x.kind == exprFloat:
+		x.f = -x.f
+		return x, nil
+	}
+	return exprValue{}, fmt.Errorf("invalid operand for '%s'", n.op)
+}
+
+type exprBinary struct {
+	op string
+	x  exprNode
+	y  exprNode
+}
+
+func (n *exprBinary) eval(fl FieldLevel) (exprValue, error) {
+	x, err := n.x.eval(fl)
+	if err != nil {
+		return x, err
+	}
+	y, err := n.y.eval(fl)
+	if err != nil {
+		return y, err
+	}
+
+	switch n.op {
+	case "==", "!=":
+		eq, err := exprEqual(x, y)
+		return exprValue{kind: exprBool, b: eq == (n.op == "==")}, err
+
+	case "<", "<=", ">", ">=":
+		c, err := exprCompare(x, y)
+		if err != nil {
+			return exprValue{}, err
+		}
+		var b bool
+		switch n.op {
+		case "<":
+			b = c < 0
+		case "<=":
+			b = c <= 0
+		case ">":
+			b = c > 0
+		default:
+			b = c >= 0
+		}
+		return exprValue{kind: exprBool, b: b}, nil
+	}
+
+	if n.op == "+" && x.kind == exprString && y.kind == exprString {
+		return exprValue{kind: exprString, s: x.s + y.s}, nil
+	}
+	return exprArith(n.op, x, y)
+}
+
+// values
+
+func newExprValueOf(fl FieldLevel, field reflect.Value) exprValue {
+	current, kind, _ := fl.ExtractType(field)
+	return newExprValue(current, kind)
+}
+
+func newExprValue(current reflect.Value, kind reflect.Kind) exprValue {
+	switch kind {
+	case reflect.Invalid, reflect.Ptr, reflect.Interface:
+		// only nil pointers and interfaces are left once the type is extracted
+		return exprValue{}
+
+	case reflect.Bool:
+		return exprValue{kind: exprBool, b: current.Bool()}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return exprValue{kind: exprInt, i: current.Int()}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if u := current.Uint(); u > math.MaxInt64 {
+			return exprValue{kind: exprFloat, f: float64(u)}
+		}
+		return exprValue{kind: exprInt, i: int64(current.Uint())}
+
+	case reflect.Float32, reflect.Float64:
+		return exprValue{kind: exprFloat, f: current.Float()}
+
+	case reflect.String:
+		return exprValue{kind: exprString, s: current.String()}
+
+	case reflect.Struct:
+		if current.Type() == timeType && current.CanInterface() {
+			return exprValue{kind: exprTime, t: current.Interface().(time.Time)}
+		}
+	}
+	return exprValue{kind: exprOther, rv: current}
+}
+
+func (v exprValue) isNumber() bool {
+	return v.kind == exprInt || v.kind == exprFloat
+}
+
+func (v exprValue) float() float64 {
+	if v.kind == exprInt {
+		return float64(v.i)
+	}
+	return v.f
+}
+
+// isNil reports whether v is nil or a nil slice, map, func or channel.
+func (v exprValue) isNil() bool {
+	if v.kind == exprNil {
+		return true
+	}
+	if v.kind != exprOther {
+		return false
+	}
+	switch v.rv.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Func, reflect.Chan:
+		return v.rv.IsNil()
+	}
+	return false
+}
+
+func exprEqual(x, y exprValue) (bool, error) {
+	switch {
+	case x.kind == exprNil || y.kind == exprNil:
+		return x.isNil() && y.isNil(), nil
+	case x.isNumber() && y.isNumber():
+		if x.kind == exprInt && y.kind == exprInt {
+			return x.i == y.i, nil
+		}
+		return x.float() == y.float(), nil
+	case x.kind != y.kind:
+		return false, errors.New("cannot compare values of different types")
+	}
+
+	switch x.kind {
+	case exprBool:
+		return x.b == y.b, nil
+	case exprString:
+		return x.s == y.s, nil
+	case exprTime:
+		return x.t.Equal(y.t), nil
+	}
+	return false, errors.New("cannot compare values of this type")
+}
+
+func exprCompare(x, y exprValue) (int, error) {
+	switch {
+	case x.kind == exprInt && y.kind == exprInt:
+		switch {
+		case x.i < y.i:
+			return -1, nil
+		case x.i > y.i:
+			return 1, nil
+		}
+		return 0, nil
+
+	case x.isNumber() && y.isNumber():
+		switch xf, yf := x.float(), y.float(); {
+		case xf < yf:
+			return -1, nil
+		case xf > yf:
+			return 1, nil
+		}
+		return 0, nil
+
+	case x.kind == exprString && y.kind == exprString:
+		return strings.Compare(x.s, y.s), nil
+
+	case x.kind == exprTime && y.kind == exprTime:
+		switch {
+		case x.t.Before(y.t):
+			return -1, nil
+		case x.t.After(y.t):
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return 0, errors.New("values cannot be ordered")
+}
+
+func exprArith(op string, x, y exprValue) (exprValue, error) {
+	if !x.isNumber() || !y.isNumber() {
+		return exprValue{}, fmt.Errorf("invalid operands for '%s'", op)
+	}
+
+	if x.kind == exprInt && y.kind == exprInt {
+		switch op {
+		case "+":
+			return exprValue{kind: exprInt, i: x.i + y.i}, nil
+		case "-":
+			return exprValue{kind: exprInt, i: x.i - y.i}, nil
+		case "*":
+			return exprValue{kind: exprInt, i: x.i * y.i}, nil
+		}
+		if y.i == 0 {
+			return exprValue{}, errors.New("division by zero")
+		}
+		if op == "/" {
+			return exprValue{kind: exprInt, i: x.i / y.i}, nil
+		}
+		return exprValue{kind: exprInt, i: x.i % y.i}, nil
+	}
+
+	xf, yf := x.float(), y.float()
+	switch op {
+	case "+":
+		return exprValue{kind: exprFloat, f: xf + yf}, nil
+	case "-":
+		return exprValue{kind: exprFloat, f: xf - yf}, nil
+	case "*":
+		return exprValue{kind: exprFloat, f: xf * yf}, nil
+	case "/":
+		if yf == 0 {
+			return exprValue{}, errors.New("division by zero")
+		}
+		return exprValue{kind: exprFloat, f: xf / yf}, nil
+	}
+	return exprValue{}, errors.New("'%' requires integers")
+}
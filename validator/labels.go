@@ -0,0 +1,119 @@
+package validator
+
+import (
+	"reflect"
+	"strings"
+
+	ut "github.com/go-playground/universal-translator"
+)
+
+const labelTag = "label"
+
+// labelParamTags are the tags whose param names a field of the same struct,
+// which is replaced by its label when translating.
+var labelParamTags = map[string]struct{}{
+	"eqfield":       {},
+	"nefield":       {},
+	"gtfield":       {},
+	"gtefield":      {},
+	"ltfield":       {},
+	"ltefield":      {},
+	"fieldcontains": {},
+	"fieldexcludes": {},
+}
+
+// RegisterFieldLabels registers the labels of the fields of a struct type for
+// the locale of trans. t may be a struct, a pointer to one or its
+// reflect.Type, and labels maps field names to labels.
+//
+// When an error is translated with trans, its Field() and, for tags such as
+// eqfield naming another field of the struct, its Param() are replaced by
+// their labels, so that translations read eg. "邮箱为必填字段". The label tag
+// of a field gives its label for locales without one registered.
+//
+// NOTE:
+// - this method is safe to call concurrently with validation and translation
+func (v *Validate) RegisterFieldLabels(trans ut.Translator, t interface{}, labels map[string]string) error {
+
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+
+	v.register(func(r *registry) {
+		m := make(map[reflect.Type]map[string]string, len(r.labels[trans])+1)
+		for k, fields := range r.labels[trans] {
+			m[k] = fields
+		}
+
+		fields := make(map[string]string, len(m[typ])+len(labels))
+		for name, label := range m[typ] {
+			fields[name] = label
+		}
+		for name, label := range labels {
+			fields[name] = label
+		}
+
+		m[typ] = fields
+		r.labels[trans] = m
+	})
+	return nil
+}
+
+// fieldLabel returns the label of the field name of typ for the locale of
+// trans, or "" if it has none.
+func (v *Validate) fieldLabel(trans ut.Translator, typ reflect.Type, name string) string {
+
+	if v != nil {
+		if label, ok := v.registry().labels[trans][typ][name]; ok {
+			return label
+		}
+	}
+
+	if fld, ok := typ.FieldByName(name); ok {
+		return fld.Tag.Get(labelTag)
+	}
+
+	return ""
+}
+
+// labeled returns fe with its field name, and the field named by its param,
+// replaced by their labels for the locale of trans.
+func (fe *fieldError) labeled(trans ut.Translator) *fieldError {
+
+	if fe.parent == nil {
+		return fe
+	}
+
+	// dive elements are labeled by the label of their field, eg. Tags[0]
+	name, index := fe.StructField(), ""
+	if idx := strings.IndexByte(name, '['); idx != -1 {
+		name, index = name[:idx], name[idx:]
+	}
+
+	label := fe.v.fieldLabel(trans, fe.parent, name)
+
+	var paramLabel string
+	if _, ok := labelParamTags[fe.actualTag]; ok {
+		paramLabel = fe.v.fieldLabel(trans, fe.parent, fe.param)
+	}
+
+	if len(label) == 0 && len(paramLabel) == 0 {
+		return fe
+	}
+
+	cp := *fe
+	if len(label) > 0 {
+		cp.label = label + index
+	}
+	cp.paramLabel = paramLabel
+	return &cp
+}
+
+// structTypeOf returns the type of current if it is a struct, or nil.
+func structTypeOf(current reflect.Value) reflect.Type {
+	if current.Kind() != reflect.Struct {
+		return nil
+	}
+	return current.Type()
+}
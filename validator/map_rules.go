@@ -0,0 +1,187 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+const mapTag = "map"
+
+// NestedRules is a rule of Map for a value holding a map, or a slice or array
+// of maps, such as decoded JSON objects. Tag validates the value itself, eg.
+// "required,min=1", and Rules every map it holds. A map[string]interface{}
+// rule is short for NestedRules with only Rules.
+type NestedRules struct {
+	Tag   string
+	Rules map[string]interface{}
+}
+
+// Map validates data, eg. decoded JSON, using rules keyed like data. A rule is
+// either a tag, applied to the value of its key, or a map[string]interface{} or
+// NestedRules validating the map, or slice or array of maps, held by the key.
+//
+// Values are validated as the value they hold rather than as interface{}, so
+// "required" reports an empty string as it would in a struct. Missing keys are
+// validated as nil values, so "required" reports them while "omitempty" skips
+// them; nested rules are skipped for missing keys unless their Tag requires
+// the key. A value which should hold maps but does not fails the "map" tag.
+//
+// It returns an error if a rule, at any depth, is not one of those types and
+// nil or ValidationErrors otherwise, with namespaces made of the keys and
+// indexes leading to the values, eg. "items[2].sku", which are translated like
+// the errors of structs.
+func (v *Validate) Map(data map[string]interface{}, rules map[string]interface{}) error {
+	return v.MapCtx(context.Background(), data, rules)
+}
+
+// MapCtx validates data like Map and allows passing of contextual validation
+// information via context.Context.
+func (v *Validate) MapCtx(ctx context.Context, data map[string]interface{}, rules map[string]interface{}) (err error) {
+
+	if err = checkMapRules(rules, nil); err != nil {
+		return
+	}
+
+	vd := v.getValidate(reflect.ValueOf(data))
+	vd.derefIfaces = true
+
+	vd.validateMap(ctx, vd.top, rules, vd.ns[0:0])
+
+	return v.putValidate(vd)
+}
+
+// checkMapRules returns an error for the first rule of rules, or of the rules
+// nested in them, which is not a tag, map[string]interface{} or NestedRules.
+// ns is the namespace of rules, ending with '.' unless they are the top level.
+func checkMapRules(rules map[string]interface{}, ns []byte) error {
+
+	keys := make([]string, 0, len(rules))
+	for key := range rules {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+
+		var nested map[string]interface{}
+
+		switch rule := rules[key].(type) {
+		case string:
+			continue
+		case map[string]interface{}:
+			nested = rule
+		case NestedRules:
+			nested = rule.Rules
+		default:
+			return fmt.Errorf("validator: invalid rule of type %T for key '%s'", rule, string(append(ns, key...)))
+		}
+
+		if err := checkMapRules(nested, append(append(ns, key...), '.')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateMap validates the keys of current, a map with string keys, having
+// rules. ns is the namespace of current, ending with '.' unless it is the top
+// level map.
+func (v *validate) validateMap(ctx context.Context, current reflect.Value, rules map[string]interface{}, ns []byte) {
+
+	keys := make([]string, 0, len(rules))
+	for key := range rules {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	keyType := current.Type().Key()
+
+	for _, key := range keys {
+
+		if v.isDone() {
+			return
+		}
+
+		// invalid when the key is missing
+		val := current.MapIndex(reflect.ValueOf(key).Convert(keyType))
+		cf := &cField{name: key, altName: key, namesEqual: true}
+
+		switch rule := rules[key].(type) {
+		case string:
+			v.validateMapValue(ctx, current, val, ns, cf, rule)
+		case map[string]interface{}:
+			v.validateNestedRules(ctx, current, val, ns, cf, NestedRules{Rules: rule})
+		case NestedRules:
+			v.validateNestedRules(ctx, current, val, ns, cf, rule)
+		}
+	}
+}
+
+// validateMapValue validates current, the value of a map key, using tag.
+func (v *validate) validateMapValue(ctx context.Context, parent reflect.Value, current reflect.Value, ns []byte, cf *cField, tag string) {
+	if len(tag) == 0 || tag == skipValidationTag {
+		return
+	}
+	v.traverseField(ctx, parent, current, ns, ns, cf, v.v.fetchCacheTag(v.r, tag))
+}
+
+// validateNestedRules validates current, the value of a map key or an element
+// of a slice or array, using rules.
+func (v *validate) validateNestedRules(ctx context.Context, parent reflect.Value, current reflect.Value, ns []byte, cf *cField, rules NestedRules) {
+
+	errs := len(v.errs)
+	v.validateMapValue(ctx, parent, current, ns, cf, rules.Tag)
+	if len(v.errs) > errs || v.isDone() {
+		return
+	}
+
+	current, kind, _ := v.extractTypeInternal(current, false)
+
+	switch {
+	case kind == reflect.Invalid || kind == reflect.Ptr || kind == reflect.Interface:
+		// missing or nil
+		return
+
+	case kind == reflect.Map && current.Type().Key().Kind() == reflect.String:
+		ns = append(ns, cf.altName...)
+		ns = append(ns, '.')
+		v.validateMap(ctx, current, rules.Rules, ns)
+
+	case kind == reflect.Slice || kind == reflect.Array:
+		elemRules := NestedRules{Rules: rules.Rules}
+		for i := 0; i < current.Len(); i++ {
+
+			if v.isDone() {
+				return
+			}
+
+			v.misc = append(v.misc[0:0], cf.altName...)
+			v.misc = append(v.misc, '[')
+			v.misc = strconv.AppendInt(v.misc, int64(i), 10)
+			v.misc = append(v.misc, ']')
+
+			name := string(v.misc)
+			v.validateNestedRules(ctx, current, current.Index(i), ns, &cField{name: name, altName: name, namesEqual: true}, elemRules)
+		}
+
+	default:
+		v.str1 = string(append(ns, cf.altName...))
+		v.errs = append(v.errs,
+			&fieldError{
+				v:              v.v,
+				tag:            mapTag,
+				actualTag:      mapTag,
+				ns:             v.str1,
+				structNs:       v.str1,
+				fieldLen:       uint8(len(cf.altName)),
+				structfieldLen: uint8(len(cf.name)),
+				value:          current.Interface(),
+				kind:           kind,
+				typ:            current.Type(),
+			},
+		)
+	}
+}
@@ -0,0 +1,407 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	modifierTag       = "mod"
+	undefinedModifier = "Undefined modifier '%s' on field '%s'"
+)
+
+// ModifierFunc modifies field, the settable value of a field, or of an element
+// when following dive, in place. param is the param of its tag, eg. "10" for
+// mod:"truncate=10". Pointers and interfaces are dereferenced before modifiers
+// run and nil values are skipped.
+type ModifierFunc func(ctx context.Context, field reflect.Value, param string) error
+
+// ModifyError is returned when a ModifierFunc fails.
+type ModifyError struct {
+	Namespace string // the struct namespace of the field, eg. User.Emails[1]
+	Tag       string
+	Err       error
+}
+
+// Error returns the ModifyError message.
+func (e *ModifyError) Error() string {
+	return fmt.Sprintf("validator: modifier '%s' failed on '%s': %v", e.Tag, e.Namespace, e.Err)
+}
+
+// Unwrap returns the error of the ModifierFunc.
+func (e *ModifyError) Unwrap() error {
+	return e.Err
+}
+
+type modCache struct {
+	lock sync.Mutex
+	m    atomic.Value // map[reflect.Type]*mStruct
+}
+
+func (mc *modCache) Get(key reflect.Type) (c *mStruct, found bool) {
+	c, found = mc.m.Load().(map[reflect.Type]*mStruct)[key]
+	return
+}
+
+func (mc *modCache) Set(key reflect.Type, value *mStruct) {
+	m := mc.m.Load().(map[reflect.Type]*mStruct)
+	nm := make(map[reflect.Type]*mStruct, len(m)+1)
+	for k, v := range m {
+		nm[k] = v
+	}
+	nm[key] = value
+	mc.m.Store(nm)
+}
+
+type mStruct struct {
+	fields []*mField
+}
+
+type mField struct {
+	idx  int
+	name string
+	mods *mTag // nil when the field has no mod tag
+}
+
+type mTag struct {
+	tag   string
+	param string
+	fn    ModifierFunc
+	dive  bool
+	next  *mTag
+}
+
+// RegisterModifier adds a modifier with the given tag, used in mod tags.
+//
+// NOTE:
+// - if the key already exists, the previous modifier will be replaced.
+// - this method is safe to call concurrently with validation, it discards the cached mod tags
+func (v *Validate) RegisterModifier(tag string, fn ModifierFunc) error {
+	if len(tag) == 0 {
+		return errors.New("Function Key cannot be empty")
+	}
+
+	if fn == nil {
+		return errors.New("Function cannot be empty")
+	}
+
+	if tag == diveTag || tag == skipValidationTag || strings.ContainsAny(tag, restrictedTagChars) {
+		panic(fmt.Sprintf(restrictedTagErr, tag))
+	}
+
+	v.register(func(r *registry) {
+		if _, ok := r.modifiers[tag]; ok {
+			r.resetModCache()
+		}
+		r.modifiers[tag] = fn
+	})
+	return nil
+}
+
+// Modify runs the modifiers of the mod tags of a struct's exposed fields, eg.
+// mod:"trim,lower", and automatically those of nested structs, before it is
+// validated. s must be a pointer to a struct.
+//
+// The modifiers of a field run in order. dive applies the modifiers following
+// it to the elements of a slice or array, or the values of a map, eg.
+// mod:"dive,trim", and runs the modifiers of the structs they hold, eg.
+// mod:"dive" for a []*Address.
+//
+// It returns InvalidValidationError for bad values passed in, ModifyError when
+// a modifier fails, and the error of ctx once it is done.
+func (v *Validate) Modify(s interface{}) error {
+	return v.ModifyCtx(context.Background(), s)
+}
+
+// ModifyCtx runs the modifiers of a struct's exposed fields like Modify and
+// allows passing of contextual information via context.Context.
+func (v *Validate) ModifyCtx(ctx context.Context, s interface{}) error {
+
+	val := reflect.ValueOf(s)
+	if val.Kind() != reflect.Ptr || val.IsNil() || val.Elem().Kind() != reflect.Struct {
+		return &InvalidValidationError{Type: reflect.TypeOf(s)}
+	}
+
+	top := val.Elem()
+	return v.modifyStruct(ctx, v.registry(), top, []byte(top.Type().Name()))
+}
+
+// modifyStruct runs the modifiers of the fields of current, an addressable
+// struct whose namespace is ns.
+func (v *Validate) modifyStruct(ctx context.Context, r *registry, current reflect.Value, ns []byte) error {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	typ := current.Type()
+	ms, ok := r.modCache.Get(typ)
+	if !ok {
+		ms = v.extractModCache(r, typ)
+	}
+
+	for _, f := range ms.fields {
+		fns := append(append(ns, '.'), f.name...)
+		if err := v.modifyField(ctx, r, current.Field(f.idx), fns, f.mods); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// modifyField runs mods on current, an addressable value whose namespace is
+// ns, then the modifiers of the fields of the struct it holds, if any.
+func (v *Validate) modifyField(ctx context.Context, r *registry, current reflect.Value, ns []byte, mods *mTag) error {
+
+	for {
+		switch current.Kind() {
+		case reflect.Ptr:
+			if current.IsNil() {
+				return nil
+			}
+			current = current.Elem()
+			continue
+
+		case reflect.Interface:
+			if current.IsNil() {
+				return nil
+			}
+			// the value of an interface is not addressable, modify a copy
+			elem := current.Elem()
+			if elem.Kind() == reflect.Ptr {
+				current = elem
+				continue
+			}
+			cp := reflect.New(elem.Type()).Elem()
+			cp.Set(elem)
+			if err := v.modifyField(ctx, r, cp, ns, mods); err != nil {
+				return err
+			}
+			current.Set(cp)
+			return nil
+		}
+		break
+	}
+
+	for ; mods != nil; mods = mods.next {
+
+		if mods.dive {
+			return v.modifyElems(ctx, r, current, ns, mods.next)
+		}
+
+		if err := mods.fn(ctx, current, mods.param); err != nil {
+			return &ModifyError{Namespace: string(ns), Tag: mods.tag, Err: err}
+		}
+	}
+
+	if current.Kind() == reflect.Struct && current.Type() != timeType {
+		return v.modifyStruct(ctx, r, current, ns)
+	}
+	return nil
+}
+
+// modifyElems runs mods on the elements of current, a slice, array or map.
+func (v *Validate) modifyElems(ctx context.Context, r *registry, current reflect.Value, ns []byte, mods *mTag) error {
+
+	switch current.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < current.Len(); i++ {
+			ens := append(append(ns, '['), strconv.Itoa(i)...)
+			if err := v.modifyField(ctx, r, current.Index(i), append(ens, ']'), mods); err != nil {
+				return err
+			}
+		}
+
+	case reflect.Map:
+		// map values are not addressable, modify copies
+		iter := current.MapRange()
+		for iter.Next() {
+			cp := reflect.New(current.Type().Elem()).Elem()
+			cp.Set(iter.Value())
+
+			ens := append(append(ns, '['), fmt.Sprintf("%v", iter.Key().Interface())...)
+			if err := v.modifyField(ctx, r, cp, append(ens, ']'), mods); err != nil {
+				return err
+			}
+			current.SetMapIndex(iter.Key(), cp)
+		}
+	}
+	return nil
+}
+
+func (v *Validate) extractModCache(r *registry, typ reflect.Type) *mStruct {
+	r.modCache.lock.Lock()
+	defer r.modCache.lock.Unlock() // leave as defer! because if inner panics, it will never get unlocked otherwise!
+
+	// could have been multiple trying to access, but once first is done this ensures struct
+	// isn't parsed again.
+	ms, ok := r.modCache.Get(typ)
+	if ok {
+		return ms
+	}
+
+	ms = &mStruct{fields: make([]*mField, 0)}
+
+	for i := 0; i < typ.NumField(); i++ {
+
+		fld := typ.Field(i)
+
+		if !fld.Anonymous && len(fld.PkgPath) > 0 {
+			continue
+		}
+
+		tag := fld.Tag.Get(modifierTag)
+		if tag == skipValidationTag {
+			continue
+		}
+
+		ms.fields = append(ms.fields, &mField{
+			idx:  i,
+			name: fld.Name,
+			mods: parseModifiers(r, tag, fld.Name),
+		})
+	}
+
+	r.modCache.Set(typ, ms)
+	return ms
+}
+
+// parseModifiers parses a mod tag of comma separated modifiers, which may have
+// a param, eg. "trim,truncate=10,dive,lower".
+func parseModifiers(r *registry, tag string, fieldName string) *mTag {
+
+	var first, current *mTag
+
+	for _, t := range strings.Split(tag, tagSeparator) {
+
+		t = strings.TrimSpace(t)
+		if len(t) == 0 {
+			continue
+		}
+
+		mt := &mTag{tag: t}
+		if idx := strings.Index(t, tagKeySeparator); idx != -1 {
+			mt.tag, mt.param = t[:idx], t[idx+1:]
+		}
+
+		if mt.tag == diveTag {
+			mt.dive = true
+		} else if mt.fn = r.modifiers[mt.tag]; mt.fn == nil {
+			panic(fmt.Sprintf(undefinedModifier, mt.tag, fieldName))
+		}
+
+		if first == nil {
+			first = mt
+		} else {
+			current.next = mt
+		}
+		current = mt
+	}
+	return first
+}
+
+var bakedInModifiers = map[string]ModifierFunc{
+	"trim":       stringModifier(strings.TrimSpace),
+	"ltrim":      stringModifier(func(s string) string { return strings.TrimLeftFunc(s, unicode.IsSpace) }),
+	"rtrim":      stringModifier(func(s string) string { return strings.TrimRightFunc(s, unicode.IsSpace) }),
+	"lower":      stringModifier(strings.ToLower),
+	"upper":      stringModifier(strings.ToUpper),
+	"title":      stringModifier(titleCase),
+	"snake":      stringModifier(snakeCase),
+	"camel":      stringModifier(camelCase),
+	"collapse":   stringModifier(func(s string) string { return strings.Join(strings.Fields(s), " ") }),
+	"strip_html": stringModifier(func(s string) string { return hTMLRegex.ReplaceAllString(s, "") }),
+	"truncate":   truncate,
+}
+
+// stringModifier returns a ModifierFunc applying fn to strings, leaving values
+// of other kinds unchanged.
+func stringModifier(fn func(s string) string) ModifierFunc {
+	return func(ctx context.Context, field reflect.Value, param string) error {
+		if field.Kind() == reflect.String {
+			field.SetString(fn(field.String()))
+		}
+		return nil
+	}
+}
+
+// truncate truncates strings to param runes.
+func truncate(ctx context.Context, field reflect.Value, param string) error {
+	n, err := strconv.Atoi(param)
+	if err != nil || n < 0 {
+		return fmt.Errorf("invalid length '%s'", param)
+	}
+	if field.Kind() != reflect.String || utf8.RuneCountInString(field.String()) <= n {
+		return nil
+	}
+	field.SetString(string([]rune(field.String())[:n]))
+	return nil
+}
+
+// titleCase upper cases the first letter of every word of s.
+func titleCase(s string) string {
+	prev := ' '
+	return strings.Map(func(r rune) rune {
+		defer func() { prev = r }()
+		if unicode.IsSpace(prev) {
+			return unicode.ToTitle(r)
+		}
+		return r
+	}, s)
+}
+
+// snakeCase converts s to snake case, eg. "userID" and "User Id" to "user_id".
+func snakeCase(s string) string {
+	return strings.ToLower(strings.Join(words(s), "_"))
+}
+
+// camelCase converts s to camel case, eg. "user_id" and "User ID" to "userId".
+func camelCase(s string) string {
+	ws := words(s)
+	for i, w := range ws {
+		w = strings.ToLower(w)
+		if i > 0 {
+			r, size := utf8.DecodeRuneInString(w)
+			w = string(unicode.ToUpper(r)) + w[size:]
+		}
+		ws[i] = w
+	}
+	return strings.Join(ws, "")
+}
+
+// words splits s into words at non alphanumeric characters and changes of case,
+// eg. "HTTPServer_port" into "HTTP", "Server" and "port".
+func words(s string) []string {
+	var ws []string
+	var w []rune
+
+	runes := []rune(s)
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			if len(w) > 0 {
+				ws, w = append(ws, string(w)), w[:0]
+			}
+			continue
+
+		case unicode.IsUpper(r) && len(w) > 0:
+			prev := runes[i-1]
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				ws, w = append(ws, string(w)), w[:0]
+			}
+		}
+		w = append(w, r)
+	}
+	if len(w) > 0 {
+		ws = append(ws, string(w))
+	}
+	return ws
+}
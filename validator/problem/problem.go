@@ -0,0 +1,261 @@
+// Package problem renders ValidationErrors as RFC 7807 problem details, served
+// as application/problem+json, with an error per field located by a JSON
+// pointer into the request body:
+//
+//	{
+//		"title": "Bad Request",
+//		"status": 400,
+//		"errors": [
+//			{"pointer": "/items/2/sku", "tag": "required", "message": "sku is a required field"}
+//		]
+//	}
+package problem
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"frames/validator"
+	ut "github.com/go-playground/universal-translator"
+)
+
+// ContentType is the media type of problem details.
+const ContentType = "application/problem+json"
+
+// Problem is an RFC 7807 problem details object extended with the errors of
+// the fields which failed validation.
+type Problem struct {
+	Type     string  `json:"type,omitempty"`
+	Title    string  `json:"title"`
+	Status   int     `json:"status,omitempty"`
+	Detail   string  `json:"detail,omitempty"`
+	Instance string  `json:"instance,omitempty"`
+	Errors   []Field `json:"errors"`
+}
+
+// Field describes a field which failed validation.
+type Field struct {
+	Pointer string      `json:"pointer"`         // JSON pointer to the field, eg. "/items/2/sku"
+	Tag     string      `json:"tag"`             // the validation tag which failed
+	Param   string      `json:"param,omitempty"` // the tag's param, eg. "10" for max=10
+	Message string      `json:"message"`
+	Value   interface{} `json:"value,omitempty"` // only set when Options.Values is
+}
+
+// Options configures the rendering of ValidationErrors.
+type Options struct {
+	// Type is a URI identifying the problem type, about:blank when empty.
+	Type string
+
+	// Title summarizes the problem type, the status text when empty.
+	Title string
+
+	// Status is the HTTP status code, 400 when zero.
+	Status int
+
+	// Translator, when set, translates the messages of the errors, which are
+	// otherwise the FieldError's Error().
+	Translator ut.Translator
+
+	// Values includes the values of the fields in the errors. Values are
+	// omitted by default as they may hold secrets.
+	Values bool
+
+	// Redact, when set along with Values, returns the value reported for an
+	// error in place of Value(), eg. "***" for a password.
+	Redact func(fe validator.FieldError) interface{}
+}
+
+// New returns the problem details of errs. obj is the value which was
+// validated, used to derive the JSON pointers of struct fields from their json
+// tags; it may be nil for errors of Map, whose namespaces are made of map keys.
+func New(errs validator.ValidationErrors, obj interface{}, opts *Options) *Problem {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	p := &Problem{
+		Type:   opts.Type,
+		Title:  opts.Title,
+		Status: opts.Status,
+		Errors: make([]Field, 0, len(errs)),
+	}
+	if p.Status == 0 {
+		p.Status = http.StatusBadRequest
+	}
+	if p.Title == "" {
+		p.Title = http.StatusText(p.Status)
+	}
+
+	typ := reflect.TypeOf(obj)
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	for _, fe := range errs {
+		f := Field{
+			Pointer: Pointer(fe, typ),
+			Tag:     fe.Tag(),
+			Param:   fe.Param(),
+		}
+		if opts.Translator != nil {
+			f.Message = fe.Translate(opts.Translator)
+		} else {
+			f.Message = fe.Error()
+		}
+		if opts.Values {
+			if opts.Redact != nil {
+				f.Value = opts.Redact(fe)
+			} else {
+				f.Value = fe.Value()
+			}
+		}
+		p.Errors = append(p.Errors, f)
+	}
+	return p
+}
+
+// Write writes p to w as application/problem+json with its status code.
+func (p *Problem) Write(w http.ResponseWriter) error {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(p.Status)
+	_, err = w.Write(b)
+	return err
+}
+
+// Pointer returns the JSON pointer of the field of fe. When typ is a struct,
+// the field is located by walking the struct namespace of fe from typ, naming
+// fields after their json tags and skipping embedded structs, which json
+// flattens. Otherwise the namespace of fe is used as is, which for struct
+// errors starts with the name of the top level struct.
+func Pointer(fe validator.FieldError, typ reflect.Type) string {
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return pointer(segments(fe.Namespace()), nil)
+	}
+
+	segs := segments(fe.StructNamespace())
+	if len(segs) > 0 && segs[0].name == typ.Name() {
+		// the top level struct
+		segs = segs[1:]
+	}
+	return pointer(segs, typ)
+}
+
+// segment is a field name followed by the indexes or map keys applied to it,
+// eg. Items[2].
+type segment struct {
+	name    string
+	indexes []string
+}
+
+// segments splits a namespace such as "User.Items[2].SKU".
+func segments(ns string) []segment {
+	var segs []segment
+	for len(ns) > 0 {
+		var seg segment
+
+		end := strings.IndexAny(ns, ".[")
+		if end == -1 {
+			end = len(ns)
+		}
+		seg.name, ns = ns[:end], ns[end:]
+
+		for strings.HasPrefix(ns, "[") {
+			end = strings.IndexByte(ns, ']')
+			if end == -1 {
+				seg.indexes = append(seg.indexes, ns[1:])
+				ns = ""
+				break
+			}
+			seg.indexes = append(seg.indexes, ns[1:end])
+			ns = ns[end+1:]
+		}
+		ns = strings.TrimPrefix(ns, ".")
+
+		segs = append(segs, seg)
+	}
+	return segs
+}
+
+// pointer renders segs as a JSON pointer, renaming the fields of typ after
+// their json names.
+func pointer(segs []segment, typ reflect.Type) string {
+	var b strings.Builder
+
+	for _, seg := range segs {
+		name := seg.name
+
+		if typ != nil {
+			typ = deref(typ)
+			if typ.Kind() == reflect.Struct {
+				if fld, ok := typ.FieldByName(seg.name); ok {
+					var skip bool
+					name, skip = jsonName(fld)
+					typ = fld.Type
+					if skip && len(seg.indexes) == 0 {
+						continue
+					}
+				} else {
+					typ = nil
+				}
+			} else {
+				typ = nil
+			}
+		}
+
+		if len(name) > 0 {
+			b.WriteByte('/')
+			b.WriteString(escape(name))
+		}
+
+		for _, idx := range seg.indexes {
+			b.WriteByte('/')
+			b.WriteString(escape(idx))
+			if typ != nil {
+				typ = deref(typ)
+				switch typ.Kind() {
+				case reflect.Slice, reflect.Array, reflect.Map:
+					typ = typ.Elem()
+				default:
+					typ = nil
+				}
+			}
+		}
+	}
+	return b.String()
+}
+
+// jsonName returns the name json encodes fld with, and whether fld is an
+// embedded struct flattened into its parent.
+func jsonName(fld reflect.StructField) (string, bool) {
+	tag := fld.Tag.Get("json")
+	if idx := strings.IndexByte(tag, ','); idx != -1 {
+		tag = tag[:idx]
+	}
+	if tag != "" && tag != "-" {
+		return tag, false
+	}
+	return fld.Name, fld.Anonymous && deref(fld.Type).Kind() == reflect.Struct
+}
+
+func deref(typ reflect.Type) reflect.Type {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ
+}
+
+// escape escapes a JSON pointer reference token, RFC 6901.
+func escape(s string) string {
+	if strings.ContainsAny(s, "~/") {
+		s = strings.Replace(s, "~", "~0", -1)
+		s = strings.Replace(s, "/", "~1", -1)
+	}
+	return s
+}
@@ -0,0 +1,119 @@
+package problem
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"frames/validator"
+	"frames/validator/translations/en"
+	. "github.com/go-playground/assert/v2"
+	english "github.com/go-playground/locales/en"
+	ut "github.com/go-playground/universal-translator"
+)
+
+type Base struct {
+	ID string `json:"id" validate:"required"`
+}
+
+type Item struct {
+	SKU string `json:"sku" validate:"required"`
+}
+
+type Order struct {
+	Base
+	Email    string            `json:"email,omitempty" validate:"required,email"`
+	Password string            `json:"password" validate:"min=8"`
+	Items    []*Item           `json:"items" validate:"dive"`
+	Labels   map[string]string `json:"labels" validate:"dive,max=3"`
+	Note     string            `validate:"max=2"`
+}
+
+func TestNew(t *testing.T) {
+	eng := english.New()
+	uni := ut.New(eng, eng)
+	trans, _ := uni.GetTranslator("en")
+
+	validate := validator.New()
+	Equal(t, en.RegisterDefaultTranslations(validate, trans), nil)
+
+	order := &Order{
+		Email:    "nope",
+		Password: "secret",
+		Items:    []*Item{{SKU: "a"}, {}},
+		Labels:   map[string]string{"a/b": "long"},
+		Note:     "abc",
+	}
+
+	errs := validate.Struct(order).(validator.ValidationErrors)
+
+	p := New(errs, order, &Options{
+		Translator: trans,
+		Values:     true,
+		Redact: func(fe validator.FieldError) interface{} {
+			if fe.StructField() == "Password" {
+				return "***"
+			}
+			return fe.Value()
+		},
+	})
+
+	Equal(t, p.Title, "Bad Request")
+	Equal(t, p.Status, http.StatusBadRequest)
+	Equal(t, len(p.Errors), 6)
+
+	Equal(t, p.Errors[0], Field{Pointer: "/id", Tag: "required", Message: "ID is a required field", Value: ""})
+	Equal(t, p.Errors[1], Field{Pointer: "/email", Tag: "email", Message: "Email must be a valid email address", Value: "nope"})
+	Equal(t, p.Errors[2], Field{Pointer: "/password", Tag: "min", Param: "8", Message: "Password must be at least 8 characters in length", Value: "***"})
+	Equal(t, p.Errors[3].Pointer, "/items/1/sku")
+	Equal(t, p.Errors[4].Pointer, "/labels/a~1b")
+	Equal(t, p.Errors[5].Pointer, "/Note")
+
+	// values are omitted by default
+	p = New(errs, order, &Options{Type: "https://example.com/invalid", Title: "Invalid order", Status: http.StatusUnprocessableEntity})
+	Equal(t, p.Errors[1], Field{Pointer: "/email", Tag: "email", Message: errs[1].Error()})
+
+	w := httptest.NewRecorder()
+	Equal(t, p.Write(w), nil)
+	Equal(t, w.Code, http.StatusUnprocessableEntity)
+	Equal(t, w.Header().Get("Content-Type"), ContentType)
+
+	var body map[string]interface{}
+	Equal(t, json.Unmarshal(w.Body.Bytes(), &body), nil)
+	Equal(t, body["type"], "https://example.com/invalid")
+	Equal(t, body["title"], "Invalid order")
+	Equal(t, body["status"], float64(422))
+	Equal(t, len(body["errors"].([]interface{})), 6)
+	Equal(t, body["errors"].([]interface{})[1], map[string]interface{}{
+		"pointer": "/email",
+		"tag":     "email",
+		"message": errs[1].Error(),
+	})
+}
+
+func TestPointerMap(t *testing.T) {
+	validate := validator.New()
+
+	errs := validate.Map(map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{}},
+	}, map[string]interface{}{
+		"items": map[string]interface{}{"sku": "required"},
+	}).(validator.ValidationErrors)
+
+	p := New(errs, nil, nil)
+	Equal(t, len(p.Errors), 1)
+	Equal(t, p.Errors[0].Pointer, "/items/0/sku")
+}
+
+func TestPointerTagNameFunc(t *testing.T) {
+	validate := validator.New()
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return "x" + fld.Name
+	})
+
+	errs := validate.Struct(Order{}).(validator.ValidationErrors)
+	Equal(t, Pointer(errs[0], reflect.TypeOf(Order{})), "/id")
+	Equal(t, Pointer(errs[0], nil), "/Order/xBase/xID")
+}
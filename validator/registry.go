@@ -0,0 +1,144 @@
+package validator
+
+import (
+	"reflect"
+
+	ut "github.com/go-playground/universal-translator"
+)
+
+// registry holds the validations, aliases, struct level and custom type funcs,
+// struct rules, variants, modifiers and field labels registered on a Validate, along with the
+// caches of the tags parsed using them.
+//
+// A registry is never modified once published. Registering copies it and
+// atomically replaces it, so registration is safe concurrently with validation;
+// a validation keeps using the registry it started with. The caches are
+// shared with the copy unless the registration changes the meaning of tags or
+// structs which may already be cached.
+type registry struct {
+	validations      map[string]internalValidationFuncWrapper
+	aliases          map[string]string
+	structLevelFuncs map[reflect.Type]StructLevelFuncCtx
+	customFuncs      map[reflect.Type]CustomTypeFunc
+	structRules      map[reflect.Type]map[string]string         // field rules replacing tags
+	variants         map[reflect.Type]map[string]*fieldVariants // interface field variants by field name
+	modifiers        map[string]ModifierFunc
+	labels           map[ut.Translator]map[reflect.Type]map[string]string // field labels by locale and struct
+	tagCache         *tagCache
+	structCache      *structCache
+	groupCache       *groupCache
+	modCache         *modCache
+	defaultsCache    *defaultsCache
+}
+
+func newRegistry() *registry {
+	r := &registry{
+		validations:      make(map[string]internalValidationFuncWrapper, len(bakedInValidators)),
+		aliases:          make(map[string]string, len(bakedInAliases)),
+		structLevelFuncs: make(map[reflect.Type]StructLevelFuncCtx),
+		customFuncs:      make(map[reflect.Type]CustomTypeFunc),
+		structRules:      make(map[reflect.Type]map[string]string),
+		variants:         make(map[reflect.Type]map[string]*fieldVariants),
+		modifiers:        make(map[string]ModifierFunc, len(bakedInModifiers)),
+		labels:           make(map[ut.Translator]map[reflect.Type]map[string]string),
+	}
+	r.resetCaches()
+	r.resetModCache()
+
+	r.defaultsCache = new(defaultsCache)
+	r.defaultsCache.m.Store(make(map[reflect.Type]*dStruct))
+	return r
+}
+
+// clone returns a copy of r sharing its caches.
+func (r *registry) clone() *registry {
+	nr := &registry{
+		validations:      make(map[string]internalValidationFuncWrapper, len(r.validations)+1),
+		aliases:          make(map[string]string, len(r.aliases)+1),
+		structLevelFuncs: make(map[reflect.Type]StructLevelFuncCtx, len(r.structLevelFuncs)+1),
+		customFuncs:      make(map[reflect.Type]CustomTypeFunc, len(r.customFuncs)+1),
+		structRules:      make(map[reflect.Type]map[string]string, len(r.structRules)+1),
+		variants:         make(map[reflect.Type]map[string]*fieldVariants, len(r.variants)+1),
+		modifiers:        make(map[string]ModifierFunc, len(r.modifiers)+1),
+		labels:           make(map[ut.Translator]map[reflect.Type]map[string]string, len(r.labels)+1),
+		tagCache:         r.tagCache,
+		structCache:      r.structCache,
+		groupCache:       r.groupCache,
+		modCache:         r.modCache,
+		defaultsCache:    r.defaultsCache,
+	}
+	for k, v := range r.validations {
+		nr.validations[k] = v
+	}
+	for k, v := range r.aliases {
+		nr.aliases[k] = v
+	}
+	for k, v := range r.structLevelFuncs {
+		nr.structLevelFuncs[k] = v
+	}
+	for k, v := range r.customFuncs {
+		nr.customFuncs[k] = v
+	}
+	for k, v := range r.structRules {
+		nr.structRules[k] = v
+	}
+	for k, v := range r.variants {
+		nr.variants[k] = v
+	}
+	for k, v := range r.modifiers {
+		nr.modifiers[k] = v
+	}
+	for k, v := range r.labels {
+		nr.labels[k] = v
+	}
+	return nr
+}
+
+// isRegistered reports whether tag is a validation or alias, in which case
+// cached tags may be using it.
+func (r *registry) isRegistered(tag string) bool {
+	if _, ok := r.validations[tag]; ok {
+		return true
+	}
+	_, ok := r.aliases[tag]
+	return ok
+}
+
+// resetCaches replaces all caches.
+func (r *registry) resetCaches() {
+	r.tagCache = new(tagCache)
+	r.tagCache.m.Store(make(map[string]*cTag))
+	r.resetStructCaches()
+}
+
+// resetStructCaches replaces the struct caches, keeping the tag cache used by
+// Var, as struct level funcs are only stored in the former.
+func (r *registry) resetStructCaches() {
+	r.structCache = new(structCache)
+	r.structCache.m.Store(make(map[reflect.Type]*cStruct))
+
+	r.groupCache = new(groupCache)
+	r.groupCache.m.Store(make(map[string]*structCache))
+}
+
+// resetModCache replaces the cache of mod tags, which is independent of the
+// validations.
+func (r *registry) resetModCache() {
+	r.modCache = new(modCache)
+	r.modCache.m.Store(make(map[reflect.Type]*mStruct))
+}
+
+// registry returns the current registry.
+func (v *Validate) registry() *registry {
+	return v.reg.Load().(*registry)
+}
+
+// register applies fn to a copy of the current registry and publishes it.
+func (v *Validate) register(fn func(r *registry)) {
+	v.regLock.Lock()
+	defer v.regLock.Unlock()
+
+	r := v.registry().clone()
+	fn(r)
+	v.reg.Store(r)
+}
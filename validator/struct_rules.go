@@ -0,0 +1,162 @@
+package validator
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+)
+
+// RegisterStructRules registers validation rules for the fields of a struct
+// type which cannot be given tags, eg. generated or third party types. t may
+// be a struct, a pointer to one or its reflect.Type.
+//
+// rules maps field names to rules written like a tag. A rule replaces the tag
+// of its field, so "-" skips the field and "" removes its rules; fields
+// without a rule keep their tags. The fields are validated and named in errors
+// exactly as if the rules were their tags. Registering rules for a type again
+// replaces its previous rules.
+//
+// It returns an error if t is not a struct, a field does not exist or is not
+// exported, or a rule is invalid, in which case no rules are registered.
+//
+// NOTE:
+// - this method is safe to call concurrently with validation, it discards all cached structs
+func (v *Validate) RegisterStructRules(t interface{}, rules map[string]string) error {
+
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+	return v.registerStructRules(map[reflect.Type]map[string]string{typ: rules})
+}
+
+// LoadStructRules reads rules for the given struct types from YAML or JSON and
+// registers them with RegisterStructRules. The rules are keyed by type name,
+// either qualified by package, eg. "pb.User", or not, then by field name:
+//
+//	pb.User:
+//	  Email: required,email
+//	  Items: dive,required
+//
+// The types may be structs, pointers to structs or their reflect.Type. It
+// returns an error if a type named in r is not among them, in which case no
+// rules are registered.
+func (v *Validate) LoadStructRules(r io.Reader, types ...interface{}) error {
+
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	var doc map[string]map[string]string
+	if err = yaml.Unmarshal(b, &doc); err != nil {
+		return fmt.Errorf("validator: invalid struct rules: %v", err)
+	}
+
+	byName := make(map[string]reflect.Type, len(types)*2)
+	for _, t := range types {
+		typ, err := structType(t)
+		if err != nil {
+			return err
+		}
+		byName[typ.String()] = typ
+		byName[typ.Name()] = typ
+	}
+
+	all := make(map[reflect.Type]map[string]string, len(doc))
+	for name, rules := range doc {
+		typ, ok := byName[name]
+		if !ok {
+			return fmt.Errorf("validator: struct rules for unknown type %s", name)
+		}
+		all[typ] = rules
+	}
+	return v.registerStructRules(all)
+}
+
+// structType returns the struct type of t, a struct, a pointer to one or
+// their reflect.Type.
+func structType(t interface{}) (reflect.Type, error) {
+	typ, ok := t.(reflect.Type)
+	if !ok {
+		typ = reflect.TypeOf(t)
+	}
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("validator: %v is not a struct type", typ)
+	}
+	return typ, nil
+}
+
+// registerStructRules checks the rules of every type before registering them
+// all at once.
+func (v *Validate) registerStructRules(all map[reflect.Type]map[string]string) error {
+
+	types := make([]reflect.Type, 0, len(all))
+	for typ := range all {
+		types = append(types, typ)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].String() < types[j].String() })
+
+	r := v.registry()
+
+	for _, typ := range types {
+		rules := all[typ]
+
+		names := make([]string, 0, len(rules))
+		for name := range rules {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			fld, ok := typ.FieldByName(name)
+			if !ok || len(fld.Index) > 1 || (!fld.Anonymous && len(fld.PkgPath) > 0) {
+				return fmt.Errorf("validator: %s has no exported field %s", typ, name)
+			}
+
+			rule := rules[name]
+			if rule == skipValidationTag {
+				continue
+			}
+
+			for _, section := range GroupSections(rule) {
+				if len(section) == 0 {
+					continue
+				}
+				if err := v.checkTag(r, section, typ, name); err != nil {
+					return &FieldTagError{Type: typ, Field: name, Tag: rule, Err: err}
+				}
+			}
+		}
+	}
+
+	v.register(func(r *registry) {
+		r.resetStructCaches()
+		for typ, rules := range all {
+			copied := make(map[string]string, len(rules))
+			for name, rule := range rules {
+				copied[name] = rule
+			}
+			r.structRules[typ] = copied
+		}
+	})
+	return nil
+}
+
+// fieldTag returns the rules of fld, a field of typ, registered with
+// RegisterStructRules or else its tag.
+func (r *registry) fieldTag(typ reflect.Type, fld reflect.StructField, tagName string) string {
+	if rules, ok := r.structRules[typ]; ok {
+		if rule, ok := rules[fld.Name]; ok {
+			return rule
+		}
+	}
+	return fld.Tag.Get(tagName)
+}
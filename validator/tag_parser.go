@@ -0,0 +1,383 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type exprKind uint8
+
+const (
+	exprTag exprKind = iota
+	exprAnd
+	exprOr
+	exprNot
+)
+
+// TagSyntaxError describes a malformed validation tag. It is raised as a panic
+// when a struct or tag is first used, the same way as any other invalid tag.
+type TagSyntaxError struct {
+	Field  string // name of the field the tag belongs to, empty for Var
+	Tag    string // the tag, or alias definition, containing the error
+	Column int    // 1-based position of the offending character within Tag
+	Msg    string
+}
+
+// Error returns the TagSyntaxError message
+func (e *TagSyntaxError) Error() string {
+	return fmt.Sprintf("Invalid validation tag on field '%s': %s at column %d of %q", e.Field, e.Msg, e.Column, e.Tag)
+}
+
+// tagExpr is a node of a parsed validation tag.
+//
+// The grammar, from lowest to highest precedence, is:
+//
+//	and   = or { "," or }
+//	or    = unary { "|" unary }
+//	unary = "!" unary | "(" and ")" | name [ "=" param ]
+//
+// Within a param, text enclosed in single quotes may contain ',', '|' and ')'.
+// A quote opens such text only when it starts the param or follows a space,
+// except in expressions, so params such as startswith=O'Brien are unchanged.
+// The quotes are kept in the param, as validations such as oneof use them to
+// delimit values.
+type tagExpr struct {
+	kind     exprKind
+	name     string
+	param    string
+	hasParam bool
+	text     string // source text of the node
+	src      string // complete tag the node was parsed from
+	pos      int    // offset of the node within src
+	args     []*tagExpr
+}
+
+type tagParser struct {
+	tag   string
+	field string
+	pos   int
+	depth int
+}
+
+// parseTag parses tag and returns its top level, comma separated, terms.
+// Parenthesized terms that are themselves comma separated are flattened into
+// the result as they mean the same thing.
+func parseTag(tag string, fieldName string) []*tagExpr {
+	return flattenAnd(parseTagExpr(tag, fieldName), nil)
+}
+
+// parseTagExpr parses tag into a single expression.
+func parseTagExpr(tag string, fieldName string) *tagExpr {
+	p := &tagParser{tag: tag, field: fieldName}
+	e := p.parseAnd()
+	if p.pos < len(tag) {
+		p.fail(p.pos, "unexpected '%c'", tag[p.pos])
+	}
+	return e
+}
+
+func flattenAnd(e *tagExpr, terms []*tagExpr) []*tagExpr {
+	if e.kind != exprAnd {
+		return append(terms, e)
+	}
+	for _, a := range e.args {
+		terms = flattenAnd(a, terms)
+	}
+	return terms
+}
+
+func (p *tagParser) fail(pos int, format string, args ...interface{}) {
+	panic(&TagSyntaxError{Field: p.field, Tag: p.tag, Column: pos + 1, Msg: fmt.Sprintf(format, args...)})
+}
+
+func (p *tagParser) node(kind exprKind, start int) *tagExpr {
+	return &tagExpr{kind: kind, src: p.tag, pos: start}
+}
+
+func (p *tagParser) parseAnd() *tagExpr {
+	return p.parseList(exprAnd, ',', p.parseOr)
+}
+
+func (p *tagParser) parseOr() *tagExpr {
+	return p.parseList(exprOr, '|', p.parseUnary)
+}
+
+func (p *tagParser) parseList(kind exprKind, sep byte, next func() *tagExpr) *tagExpr {
+	start := p.pos
+	e := p.node(kind, start)
+	for {
+		e.args = append(e.args, next())
+		if p.pos < len(p.tag) && p.tag[p.pos] == sep {
+			p.pos++
+			continue
+		}
+		break
+	}
+	if len(e.args) == 1 {
+		return e.args[0]
+	}
+	e.text = p.tag[start:p.pos]
+	return e
+}
+
+func (p *tagParser) parseUnary() *tagExpr {
+	start := p.pos
+	if p.pos == len(p.tag) {
+		p.fail(p.pos, "missing validation tag")
+	}
+
+	switch p.tag[p.pos] {
+	case ',', '|':
+		p.fail(p.pos, "empty validation tag")
+	case ')':
+		if p.depth == 0 {
+			p.fail(p.pos, "unexpected ')'")
+		}
+		p.fail(p.pos, "empty validation tag")
+	case '!':
+		p.pos++
+		e := p.node(exprNot, start)
+		e.args = []*tagExpr{p.parseUnary()}
+		e.text = p.tag[start:p.pos]
+		return e
+	case '(':
+		p.pos++
+		p.depth++
+		e := p.parseAnd()
+		if p.pos == len(p.tag) || p.tag[p.pos] != ')' {
+			p.fail(start, "missing closing ')'")
+		}
+		p.pos++
+		p.depth--
+		if e.kind == exprTag {
+			return e
+		}
+		// keep the parentheses so the text of an enclosing node stays valid
+		e.text = p.tag[start:p.pos]
+		e.pos = start
+		return e
+	}
+
+	return p.parseTerm()
+}
+
+func (p *tagParser) parseTerm() *tagExpr {
+	start := p.pos
+	for p.pos < len(p.tag) && strings.IndexByte(",|=()", p.tag[p.pos]) == -1 {
+		p.pos++
+	}
+
+	e := p.node(exprTag, start)
+	e.name = p.tag[start:p.pos]
+
+	if p.pos < len(p.tag) {
+		switch c := p.tag[p.pos]; {
+		case c == '(':
+			p.fail(p.pos, "unexpected '('")
+		case c == ')' && p.depth == 0:
+			p.fail(p.pos, "unexpected ')'")
+		case c == '=' && len(e.name) == 0:
+			p.fail(p.pos, "missing validation tag before '='")
+		}
+	}
+
+	if p.pos < len(p.tag) && p.tag[p.pos] == '=' {
+		p.pos++
+		e.hasParam = true
+		paramStart := p.pos
+
+		// expressions may contain '||' and, within parentheses, ','
+		isExpr := e.name == expressionTag || e.name == whenTag
+		// transitions are separated by '|', eg. transition=draft>published|published>archived
+		isTransition := e.name == transitionTag
+		// quotes delimit the values of oneof and the strings of expressions, a
+		// quote which is not closed is literal in other params, eg. contains='
+		strictQuotes := isExpr || e.name == oneofTag
+		parens := 0
+
+	PARAM:
+		for p.pos < len(p.tag) {
+			switch p.tag[p.pos] {
+			case '\'':
+				if !isExpr && p.pos > paramStart && p.tag[p.pos-1] != ' ' {
+					break
+				}
+				idx := strings.IndexByte(p.tag[p.pos+1:], '\'')
+				if idx == -1 {
+					if strictQuotes {
+						p.fail(p.pos, "unterminated quote")
+					}
+					break
+				}
+				p.pos += idx + 2
+				continue
+			case '(':
+				if isExpr {
+					parens++
+				}
+			case ',':
+				if parens == 0 {
+					break PARAM
+				}
+			case '|':
+				if isExpr && strings.HasPrefix(p.tag[p.pos:], "||") {
+					p.pos += 2
+					continue
+				}
+				if isTransition {
+					p.pos++
+					continue
+				}
+				if parens == 0 {
+					break PARAM
+				}
+			case ')':
+				if parens > 0 {
+					parens--
+				} else if p.depth > 0 {
+					break PARAM
+				}
+			}
+			p.pos++
+		}
+		e.param = p.tag[paramStart:p.pos]
+	}
+
+	e.text = p.tag[start:p.pos]
+	return e
+}
+
+// isSimpleTerm reports whether e can be represented by the plain cTag chain,
+// being a single tag or an 'or' of single tags which are not aliases.
+func (r *registry) isSimpleTerm(e *tagExpr) bool {
+	switch e.kind {
+	case exprTag:
+		return true
+	case exprOr:
+		for _, a := range e.args {
+			if a.kind != exprTag {
+				return false
+			}
+			if _, isAlias := r.aliases[a.name]; isAlias && !a.hasParam {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// compileTagExpr turns an expression using grouping or negation into a single
+// validation function. The returned bool reports whether it must be run even
+// if the field is nil.
+func (v *Validate) compileTagExpr(r *registry, e *tagExpr, fieldName string) (FuncCtx, bool) {
+
+	switch e.kind {
+	case exprNot:
+		fn, runOnNil := v.compileTagExpr(r, e.args[0], fieldName)
+		return func(ctx context.Context, fl FieldLevel) bool {
+			return !fn(ctx, fl)
+		}, runOnNil
+
+	case exprAnd, exprOr:
+		fns := make([]FuncCtx, len(e.args))
+		var runOnNil bool
+		for i, a := range e.args {
+			var n bool
+			fns[i], n = v.compileTagExpr(r, a, fieldName)
+			runOnNil = runOnNil || n
+		}
+		want := e.kind == exprOr
+		return func(ctx context.Context, fl FieldLevel) bool {
+			for _, fn := range fns {
+				if fn(ctx, fl) == want {
+					return want
+				}
+			}
+			return !want
+		}, runOnNil
+	}
+
+	if tagsVal, found := r.aliases[e.name]; found && !e.hasParam {
+		return v.compileTagExpr(r, parseTagExpr(tagsVal, fieldName), fieldName)
+	}
+
+	switch e.name {
+	case diveTag, keysTag, endKeysTag, omitempty, structOnlyTag, noStructLevelTag:
+		panic(&TagSyntaxError{
+			Field:  fieldName,
+			Tag:    e.src,
+			Column: e.pos + 1,
+			Msg:    fmt.Sprintf("'%s' cannot be used within a grouped or negated expression", e.name),
+		})
+	}
+
+	wrapper, ok := r.validations[e.name]
+	if !ok {
+		panic(strings.TrimSpace(fmt.Sprintf(undefinedValidation, e.name, fieldName)))
+	}
+
+	ct := &cTag{
+		tag:                  e.name,
+		aliasTag:             e.name,
+		hasTag:               true,
+		hasParam:             e.hasParam,
+		param:                unescapeParam(e.param),
+		fn:                   wrapper.fn,
+		runValidationWhenNil: wrapper.runValidatinOnNil,
+	}
+
+	v.compileParam(ct, e, fieldName)
+
+	return func(ctx context.Context, fl FieldLevel) bool {
+		// validations read their param from the current cTag
+		vd := fl.(*validate)
+		prev := vd.ct
+		vd.ct = ct
+		ok := ct.fn(ctx, fl)
+		vd.ct = prev
+		return ok
+	}, ct.runValidationWhenNil
+}
+
+// compileParam compiles the param of validations which are not evaluated from
+// the raw param string, storing the result on ct.
+func (v *Validate) compileParam(ct *cTag, e *tagExpr, fieldName string) {
+	switch ct.tag {
+	case expressionTag, whenTag:
+		prog, err := compileExpr(ct.param)
+		if err != nil {
+			se := err.(*exprSyntaxError)
+			column := e.pos + len(e.name) + 1
+			if e.hasParam {
+				column += se.pos + 1
+			}
+			panic(&TagSyntaxError{
+				Field:  fieldName,
+				Tag:    e.src,
+				Column: column,
+				Msg:    fmt.Sprintf("invalid '%s' expression: %s", ct.tag, se.msg),
+			})
+		}
+		ct.expr = prog
+
+	case transitionTag:
+		ts, pos, err := compileTransitions(ct.param)
+		if err != nil {
+			panic(&TagSyntaxError{
+				Field:  fieldName,
+				Tag:    e.src,
+				Column: e.pos + len(e.name) + pos + 2,
+				Msg:    fmt.Sprintf("invalid '%s' param: %s", ct.tag, err),
+			})
+		}
+		ct.transitions = ts
+	}
+}
+
+// unescapeParam replaces the UTF-8 hex representations of ',' and '|' which
+// are still supported for params that are not quoted.
+func unescapeParam(param string) string {
+	return strings.Replace(strings.Replace(param, utf8HexComma, ",", -1), utf8Pipe, "|", -1)
+}
@@ -0,0 +1,324 @@
+// Package catalog registers validator translations from JSON or YAML catalogs,
+// eg. embedded with embed.FS, so that messages can be changed without changing
+// code.
+//
+// A catalog is a file named after its locale, eg. zh.yaml, en.json or
+// zh_tw.yml, mapping tags to messages. {0} is replaced by the field name and
+// {1} by the param of the tag:
+//
+//	required: "{0} is a required field"
+//	startswith: "{0} must start with the text '{1}'"
+//
+// A message whose param is a count may be given plural forms, registered with
+// ut.AddCardinal, which the param is formatted with, and may have messages
+// specific to strings, counting characters, and to slices, arrays and maps,
+// counting items:
+//
+//	min:
+//	  message: "{0} must be {1} or greater"
+//	  string:
+//	    message: "{0} must be at least {1} in length"
+//	    plural:
+//	      one: "{0} character"
+//	      other: "{0} characters"
+//	  items:
+//	    message: "{0} must contain at least {1}"
+//	    plural:
+//	      one: "{0} item"
+//	      other: "{0} items"
+//
+// The plural forms are named zero, one, two, few, many and other; forms the
+// locale has but a catalog lacks use the other form.
+//
+// Translations are resolved through a chain of locales, so that a tag missing
+// from the catalog of a locale is taken from the next one which has it, eg.
+// zh_Hant_TW, zh_tw, zh and finally en.
+package catalog
+
+import (
+	"fmt"
+	"io/fs"
+	"log"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"frames/validator"
+	"github.com/go-playground/locales"
+	ut "github.com/go-playground/universal-translator"
+	"gopkg.in/yaml.v2"
+)
+
+// DefaultLocale is the locale ending every chain returned by Fallbacks.
+const DefaultLocale = "en"
+
+// Entry is the translation of a tag in a catalog.
+type Entry struct {
+	Message string            `yaml:"message"`
+	Plural  map[string]string `yaml:"plural,omitempty"`
+	String  *Entry            `yaml:"string,omitempty"` // for strings
+	Items   *Entry            `yaml:"items,omitempty"`  // for slices, arrays and maps
+}
+
+// UnmarshalYAML allows an entry with only a message to be written as a string.
+func (e *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if err := unmarshal(&e.Message); err == nil {
+		return nil
+	}
+	type entry Entry
+	return unmarshal((*entry)(e))
+}
+
+// Catalog maps tags to their translations.
+type Catalog map[string]*Entry
+
+// Parse parses a JSON or YAML catalog.
+func Parse(b []byte) (Catalog, error) {
+	var c Catalog
+	if err := yaml.Unmarshal(b, &c); err != nil {
+		return nil, fmt.Errorf("catalog: invalid catalog: %v", err)
+	}
+	for tag, e := range c {
+		if e == nil {
+			return nil, fmt.Errorf("catalog: no translation for tag '%s'", tag)
+		}
+		for _, f := range []*Entry{e, e.String, e.Items} {
+			switch {
+			case f == nil:
+			case f.Message == "":
+				return nil, fmt.Errorf("catalog: no message for tag '%s'", tag)
+			case strings.Count(f.Message, "{") > 2:
+				// only the field name and param are passed to messages
+				return nil, fmt.Errorf("catalog: too many params in message for tag '%s'", tag)
+			}
+		}
+	}
+	return c, nil
+}
+
+// Fallbacks returns the chain of locales translations of locale are resolved
+// through: locale, then without its script and region, and DefaultLocale, eg.
+// zh_Hant_TW, zh_TW, zh_Hant, zh and en.
+func Fallbacks(locale string) []string {
+	parts := strings.Split(strings.Replace(locale, "-", "_", -1), "_")
+
+	var script, region string
+	for _, p := range parts[1:] {
+		if len(p) == 4 {
+			script = p
+		} else {
+			region = p
+		}
+	}
+
+	chain := []string{locale}
+	add := func(l string) {
+		for _, c := range chain {
+			if normalize(c) == normalize(l) {
+				return
+			}
+		}
+		chain = append(chain, l)
+	}
+	if script != "" && region != "" {
+		add(parts[0] + "_" + region)
+		add(parts[0] + "_" + script)
+	}
+	add(parts[0])
+	add(DefaultLocale)
+	return chain
+}
+
+// Register registers the translations of the catalogs in the root of fsys for
+// trans, taking each tag from the first locale of chain whose catalog has it.
+// Locales are matched to file names ignoring case and the extension, so
+// zh_TW matches zh_tw.yaml, and locales without a catalog are skipped. When
+// chain is empty it is Fallbacks(trans.Locale()).
+//
+// Translations replace those already registered for trans, eg. by
+// en.RegisterDefaultTranslations, so that a catalog may reword some tags only.
+// It returns an error if no locale of chain has a catalog.
+func Register(v *validator.Validate, trans ut.Translator, fsys fs.FS, chain ...string) error {
+
+	if len(chain) == 0 {
+		chain = Fallbacks(trans.Locale())
+	}
+
+	catalogs, err := load(fsys, chain)
+	if err != nil {
+		return err
+	}
+
+	if len(catalogs) == 0 {
+		return fmt.Errorf("catalog: no catalog for %s", strings.Join(chain, ", "))
+	}
+
+	resolved := make(Catalog)
+	for _, c := range catalogs {
+		for tag, e := range c {
+			if _, ok := resolved[tag]; !ok {
+				resolved[tag] = e
+			}
+		}
+	}
+
+	for tag, e := range resolved {
+		if err = v.RegisterTranslation(tag, trans, registrationFunc(tag, e), translateFunc(tag, e)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// load loads the catalogs of the locales of chain found in fsys, in order.
+func load(fsys fs.FS, chain []string) ([]Catalog, error) {
+
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]string)
+	for _, e := range entries {
+		name := e.Name()
+		ext := path.Ext(name)
+		if e.IsDir() || (ext != ".json" && ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+
+		locale := normalize(strings.TrimSuffix(name, ext))
+		if other, ok := files[locale]; ok {
+			return nil, fmt.Errorf("catalog: both %s and %s are catalogs of locale %s", other, name, locale)
+		}
+		files[locale] = name
+	}
+
+	var catalogs []Catalog
+	for _, locale := range chain {
+		name, ok := files[normalize(locale)]
+		if !ok {
+			continue
+		}
+
+		b, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+
+		c, err := Parse(b)
+		if err != nil {
+			return nil, fmt.Errorf("%v in %s", err, name)
+		}
+		catalogs = append(catalogs, c)
+	}
+	return catalogs, nil
+}
+
+func normalize(locale string) string {
+	return strings.ToLower(strings.Replace(locale, "-", "_", -1))
+}
+
+// key is the key of a message of a tag in a ut.Translator, which does not
+// conflict with the keys used by the translations packages.
+type key struct {
+	tag    string
+	form   string // "", "string" or "items"
+	plural bool
+}
+
+func registrationFunc(tag string, e *Entry) validator.RegisterTranslationsFunc {
+	return func(ut ut.Translator) (err error) {
+		forms := map[string]*Entry{"": e, "string": e.String, "items": e.Items}
+		for form, f := range forms {
+			if f == nil {
+				continue
+			}
+			if err = add(ut, key{tag: tag, form: form}, f); err != nil {
+				return
+			}
+		}
+		return
+	}
+}
+
+// add adds the message and plural forms of e to trans.
+func add(trans ut.Translator, k key, e *Entry) error {
+
+	if err := trans.Add(k, e.Message, true); err != nil {
+		return err
+	}
+
+	if len(e.Plural) == 0 {
+		return nil
+	}
+
+	k.plural = true
+	for _, rule := range trans.PluralsCardinal() {
+		text, ok := pluralForm(e.Plural, rule)
+		if !ok {
+			return fmt.Errorf("catalog: no '%s' plural form for tag '%s' in locale %s", strings.ToLower(rule.String()), k.tag, trans.Locale())
+		}
+		if err := trans.AddCardinal(k, text, rule, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pluralForm returns the form of rule, or the other form.
+func pluralForm(plural map[string]string, rule locales.PluralRule) (string, bool) {
+	for name, text := range plural {
+		if strings.EqualFold(name, rule.String()) {
+			return text, true
+		}
+	}
+	text, ok := plural["other"]
+	return text, ok
+}
+
+func translateFunc(tag string, e *Entry) validator.TranslationFunc {
+	return func(ut ut.Translator, fe validator.FieldError) string {
+
+		k, f := key{tag: tag}, e
+
+		kind := fe.Kind()
+		if kind == reflect.Ptr {
+			kind = fe.Type().Elem().Kind()
+		}
+
+		switch kind {
+		case reflect.String:
+			if e.String != nil {
+				k.form, f = "string", e.String
+			}
+		case reflect.Slice, reflect.Array, reflect.Map:
+			if e.Items != nil {
+				k.form, f = "items", e.Items
+			}
+		}
+
+		param := fe.Param()
+		if len(f.Plural) > 0 {
+			if f64, err := strconv.ParseFloat(param, 64); err == nil {
+				var digits uint64
+				if idx := strings.Index(param, "."); idx != -1 {
+					digits = uint64(len(param[idx+1:]))
+				}
+
+				pk := k
+				pk.plural = true
+				if c, err := ut.C(pk, f64, digits, ut.FmtNumber(f64, digits)); err == nil {
+					param = c
+				}
+			}
+		}
+
+		t, err := ut.T(k, fe.Field(), param)
+		if err != nil {
+			log.Printf("warning: error translating FieldError: %#v", fe)
+			return fe.(error).Error()
+		}
+		return t
+	}
+}
@@ -0,0 +1,127 @@
+package catalog
+
+import (
+	"embed"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"frames/validator"
+	"frames/validator/translations/en"
+	. "github.com/go-playground/assert/v2"
+	english "github.com/go-playground/locales/en"
+	chinese_tw "github.com/go-playground/locales/zh_Hant_TW"
+	ut "github.com/go-playground/universal-translator"
+)
+
+//go:embed testdata
+var testdata embed.FS
+
+type User struct {
+	Name  string   `validate:"required"`
+	Email string   `validate:"email"`
+	Nick  string   `validate:"min=3"`
+	Tags  []string `validate:"min=1"`
+	Age   int      `validate:"min=18"`
+}
+
+func TestFallbacks(t *testing.T) {
+	Equal(t, Fallbacks("zh_Hant_TW"), []string{"zh_Hant_TW", "zh_TW", "zh_Hant", "zh", "en"})
+	Equal(t, Fallbacks("pt-BR"), []string{"pt-BR", "pt", "en"})
+	Equal(t, Fallbacks("en_US"), []string{"en_US", "en"})
+	Equal(t, Fallbacks("en"), []string{"en"})
+}
+
+func TestRegister(t *testing.T) {
+	fsys, err := fs.Sub(testdata, "testdata")
+	Equal(t, err, nil)
+
+	tw := chinese_tw.New()
+	uni := ut.New(tw, tw)
+	trans, _ := uni.GetTranslator("zh_Hant_TW")
+
+	validate := validator.New()
+	Equal(t, Register(validate, trans, fsys), nil)
+
+	errs := validate.Struct(User{Nick: "ab", Email: "x"}).(validator.ValidationErrors)
+	Equal(t, len(errs), 5)
+	Equal(t, errs[0].Translate(trans), "Name為必填欄位")                           // zh_tw
+	Equal(t, errs[1].Translate(trans), "Email must be a valid email address") // en
+	Equal(t, errs[2].Translate(trans), "Nick长度必须至少为3个字符")                     // zh
+	Equal(t, errs[3].Translate(trans), "Tags最小只能为1")                          // zh, without items
+	Equal(t, errs[4].Translate(trans), "Age最小只能为18")
+
+	// an explicit chain
+	validate = validator.New()
+	Equal(t, Register(validate, trans, fsys, "en"), nil)
+	errs = validate.Struct(User{Nick: "ab", Email: "x"}).(validator.ValidationErrors)
+	Equal(t, errs[0].Translate(trans), "Name is a required field")
+}
+
+func TestRegisterPlurals(t *testing.T) {
+	fsys, _ := fs.Sub(testdata, "testdata")
+
+	eng := english.New()
+	uni := ut.New(eng, eng)
+	trans, _ := uni.GetTranslator("en")
+
+	validate := validator.New()
+	Equal(t, Register(validate, trans, fsys), nil)
+
+	errs := validate.Struct(User{Name: "a", Email: "a@b.c", Nick: "ab", Tags: []string{}, Age: 1}).(validator.ValidationErrors)
+	Equal(t, len(errs), 3)
+	Equal(t, errs[0].Translate(trans), "Nick must be at least 3 characters in length")
+	Equal(t, errs[1].Translate(trans), "Tags must contain at least 1 item")
+	Equal(t, errs[2].Translate(trans), "Age must be 18 or greater")
+
+	// the zh catalog only has the other form, used for every form of en
+	validate = validator.New()
+	Equal(t, Register(validate, trans, fsys, "zh"), nil)
+	errs = validate.Struct(User{Name: "a", Email: "a@b.c", Nick: "a", Tags: []string{"a"}, Age: 18}).(validator.ValidationErrors)
+	Equal(t, errs[0].Translate(trans), "Nick长度必须至少为3个字符")
+}
+
+func TestRegisterOverrides(t *testing.T) {
+	fsys := fstest.MapFS{
+		"en.yml": {Data: []byte(`required: "Please fill in {0}"`)},
+	}
+
+	eng := english.New()
+	uni := ut.New(eng, eng)
+	trans, _ := uni.GetTranslator("en")
+
+	validate := validator.New()
+	Equal(t, en.RegisterDefaultTranslations(validate, trans), nil)
+	Equal(t, Register(validate, trans, fsys), nil)
+
+	errs := validate.Struct(User{Email: "x"}).(validator.ValidationErrors)
+	Equal(t, errs[0].Translate(trans), "Please fill in Name")
+	Equal(t, errs[1].Translate(trans), "Email must be a valid email address")
+}
+
+func TestRegisterErrors(t *testing.T) {
+	eng := english.New()
+	uni := ut.New(eng, eng)
+	trans, _ := uni.GetTranslator("en")
+
+	validate := validator.New()
+
+	err := Register(validate, trans, fstest.MapFS{"fr.yaml": {Data: []byte(`required: "{0}"`)}})
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "catalog: no catalog for en")
+
+	err = Register(validate, trans, fstest.MapFS{
+		"en.yaml": {Data: []byte(`required: "{0}"`)},
+		"EN.json": {Data: []byte(`{"required": "{0}"}`)},
+	})
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "catalog: both EN.json and en.yaml are catalogs of locale en")
+
+	err = Register(validate, trans, fstest.MapFS{"en.yaml": {Data: []byte(`min: {plural: {other: "{0}"}}`)}})
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "catalog: no message for tag 'min' in en.yaml")
+
+	err = Register(validate, trans, fstest.MapFS{"en.yaml": {Data: []byte(`min: {message: "{0} {1}", plural: {one: "{0}"}}`)}})
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "catalog: no 'other' plural form for tag 'min' in locale en")
+}
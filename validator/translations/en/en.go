@@ -1333,6 +1333,445 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					return fe.(error).Error()
 				}
 
+				return t
+			},
+		},
+		{
+			tag:         "type_int",
+			translation: "{0} must be a valid integer",
+			override:    false,
+		},
+		{
+			tag:         "type_uint",
+			translation: "{0} must be a valid non-negative integer",
+			override:    false,
+		},
+		{
+			tag:         "type_float",
+			translation: "{0} must be a valid number",
+			override:    false,
+		},
+		{
+			tag:         "type_bool",
+			translation: "{0} must be a valid boolean",
+			override:    false,
+		},
+		{
+			tag:         "type_time",
+			translation: "{0} must be a valid time",
+			override:    false,
+		},
+		{
+			tag:         "type_duration",
+			translation: "{0} must be a valid duration",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} can only contain unicode alphanumeric characters",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} can only contain unicode alphabetic characters",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} must be a valid Base64 URL string",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} must be a valid BCP 47 language tag",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} must be a valid Business Identifier Code (ISO 9362)",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} must be a valid Bitcoin address",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} must be a valid Bech32 Bitcoin address",
+			override:    false,
+		},
+		{
+			tag:         "containsrune",
+			translation: "{0} must contain the character '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} must be a valid country code",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0} must be an existing directory",
+			override:    false,
+		},
+		{
+			tag:         "endsnotwith",
+			translation: "{0} cannot end with the text '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "endswith",
+			translation: "{0} must end with the text '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} must be a valid Ethereum address",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{0} must not be set when {1} is present",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{0} must not be set when all of {1} are present",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{0} must not be set when {1} is absent",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{0} must not be set when all of {1} are absent",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "expr",
+			translation: "{0} is invalid",
+			override:    false,
+		},
+		{
+			tag:         "fieldcontains",
+			translation: "{0} must contain the value of {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "fieldexcludes",
+			translation: "{0} must not contain the value of {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "file",
+			translation: "{0} must be an existing file",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0} must be a valid fully qualified domain name",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} must be a valid hostname (RFC 952)",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} must be a valid host and port",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} must be a valid hostname (RFC 1123)",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} must contain HTML",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} must be HTML encoded",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0} must be left at its default value",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} must be a valid ISO 3166-1 alpha-2 country code",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} must be a valid ISO 3166-1 alpha-3 country code",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} must be a valid ISO 3166-1 numeric country code",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} must be a valid ISO 3166-2 subdivision code",
+			override:    false,
+		},
+		{
+			tag:         "required_if",
+			translation: "{0} is a required field",
+			override:    false,
+		},
+		{
+			tag:         "required_unless",
+			translation: "{0} is a required field",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "{0} is required when {1} is present",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_with_all",
+			translation: "{0} is required when all of {1} are present",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without",
+			translation: "{0} is required when {1} is absent",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without_all",
+			translation: "{0} is required when all of {1} are absent",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startsnotwith",
+			translation: "{0} cannot start with the text '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startswith",
+			translation: "{0} must start with the text '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} must be a valid time zone",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} must be URL encoded",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} must be a valid URN (RFC 2141)",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} must be a valid version 3 UUID (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} must be a valid version 4 UUID (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} must be a valid version 5 UUID (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} must be a valid UUID (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "when",
+			translation: "{0} is a required field",
+			override:    false,
+		},
+		{
+			tag:         "immutable",
+			translation: "{0} cannot be changed",
+			override:    false,
+		},
+		{
+			tag:         "transition",
+			translation: "{0} cannot be changed to this value",
+			override:    false,
+		},
+		{
+			tag:         "monotonic",
+			translation: "{0} cannot decrease",
+			override:    false,
+		},
+		{
+			tag:         "map",
+			translation: "{0} must be an object or a list of objects",
+			override:    false,
+		},
+		{
+			tag:         "variant",
+			translation: "{0} is not a valid {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
 				return t
 			},
 		},
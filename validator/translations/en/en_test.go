@@ -683,3 +683,112 @@ func TestTranslations(t *testing.T) {
 		Equal(t, tt.expected, fe.Translate(trans))
 	}
 }
+
+func TestMapTranslations(t *testing.T) {
+	eng := english.New()
+	uni := ut.New(eng, eng)
+	trans, _ := uni.GetTranslator("en")
+
+	validate := validator.New()
+
+	err := RegisterDefaultTranslations(validate, trans)
+	Equal(t, err, nil)
+
+	data := map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"sku": "A1", "qty": 3},
+			map[string]interface{}{"qty": 0},
+		},
+		"address": "Main Street",
+	}
+	rules := map[string]interface{}{
+		"email":   "required,email",
+		"items":   map[string]interface{}{"sku": "required", "qty": "min=1"},
+		"address": map[string]interface{}{"city": "required"},
+	}
+
+	errs := validate.Map(data, rules).(validator.ValidationErrors)
+	Equal(t, len(errs), 4)
+	Equal(t, errs[0].Namespace(), "address")
+	Equal(t, errs[0].Translate(trans), "address must be an object or a list of objects")
+	Equal(t, errs[1].Namespace(), "email")
+	Equal(t, errs[1].Translate(trans), "email is a required field")
+	Equal(t, errs[2].Namespace(), "items[1].qty")
+	Equal(t, errs[2].Translate(trans), "qty must be 1 or greater")
+	Equal(t, errs[3].Namespace(), "items[1].sku")
+	Equal(t, errs[3].Translate(trans), "sku is a required field")
+}
+
+type variantPayment interface {
+	isPayment()
+}
+
+type variantCard struct {
+	Number string `validate:"required"`
+}
+
+func (variantCard) isPayment() {}
+
+type variantBank struct {
+	IBAN string `validate:"required"`
+}
+
+func (variantBank) isPayment() {}
+
+func TestVariantTranslations(t *testing.T) {
+	eng := english.New()
+	uni := ut.New(eng, eng)
+	trans, _ := uni.GetTranslator("en")
+
+	validate := validator.New()
+
+	err := RegisterDefaultTranslations(validate, trans)
+	Equal(t, err, nil)
+
+	type Order struct {
+		Type    string
+		Payment variantPayment
+	}
+
+	err = validate.RegisterVariants(Order{}, "Payment", "Type", map[string]interface{}{
+		"card": variantCard{},
+		"bank": variantBank{},
+	})
+	Equal(t, err, nil)
+
+	errs := validate.Struct(Order{Type: "card", Payment: variantBank{IBAN: "x"}}).(validator.ValidationErrors)
+	Equal(t, len(errs), 1)
+	Equal(t, errs[0].Tag(), "variant")
+	Equal(t, errs[0].Translate(trans), "Payment is not a valid card")
+}
+
+func TestConditionalTranslations(t *testing.T) {
+	eng := english.New()
+	uni := ut.New(eng, eng)
+	trans, _ := uni.GetTranslator("en")
+
+	validate := validator.New()
+
+	err := RegisterDefaultTranslations(validate, trans)
+	Equal(t, err, nil)
+
+	type Test struct {
+		Email     string
+		Phone     string `validate:"required_without=Email"`
+		Password  string
+		Confirm   string `validate:"required_with=Password"`
+		Code      string `validate:"startswith=AB"`
+		Reference string `validate:"fieldcontains=Code"`
+		Host      string `validate:"hostname"`
+	}
+
+	test := Test{Password: "secret", Code: "XY", Host: "-"}
+
+	errs := validate.Struct(test).(validator.ValidationErrors)
+	Equal(t, len(errs), 5)
+	Equal(t, errs[0].Translate(trans), "Phone is required when Email is absent")
+	Equal(t, errs[1].Translate(trans), "Confirm is required when Password is present")
+	Equal(t, errs[2].Translate(trans), "Code must start with the text 'AB'")
+	Equal(t, errs[3].Translate(trans), "Reference must contain the value of Code")
+	Equal(t, errs[4].Translate(trans), "Host must be a valid hostname (RFC 952)")
+}
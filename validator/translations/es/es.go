@@ -1321,6 +1321,521 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				return s
 			},
 		},
+		{
+			tag:         "type_int",
+			translation: "{0} debe ser un número entero válido",
+			override:    false,
+		},
+		{
+			tag:         "type_uint",
+			translation: "{0} debe ser un número entero no negativo válido",
+			override:    false,
+		},
+		{
+			tag:         "type_float",
+			translation: "{0} debe ser un número válido",
+			override:    false,
+		},
+		{
+			tag:         "type_bool",
+			translation: "{0} debe ser un valor booleano válido",
+			override:    false,
+		},
+		{
+			tag:         "type_time",
+			translation: "{0} debe ser una fecha y hora válida",
+			override:    false,
+		},
+		{
+			tag:         "type_duration",
+			translation: "{0} debe ser una duración válida",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} sólo puede contener caracteres alfanuméricos unicode",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} sólo puede contener caracteres alfabéticos unicode",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} debe ser una cadena Base64 URL válida",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} debe ser una etiqueta de idioma BCP 47 válida",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} debe ser un código BIC (ISO 9362) válido",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} debe ser una dirección Bitcoin válida",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} debe ser una dirección Bitcoin Bech32 válida",
+			override:    false,
+		},
+		{
+			tag:         "containsrune",
+			translation: "{0} debe contener el carácter '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} debe ser un código de país válido",
+			override:    false,
+		},
+		{
+			tag:         "datetime",
+			translation: "{0} no coincide con el formato {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "dir",
+			translation: "{0} debe ser un directorio existente",
+			override:    false,
+		},
+		{
+			tag:         "endsnotwith",
+			translation: "{0} no puede terminar con el texto '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "endswith",
+			translation: "{0} debe terminar con el texto '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} debe ser una dirección Ethereum válida",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{0} no debe estar presente cuando {1} está presente",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{0} no debe estar presente cuando todos los campos {1} están presentes",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{0} no debe estar presente cuando {1} está ausente",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{0} no debe estar presente cuando todos los campos {1} están ausentes",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "expr",
+			translation: "{0} no es válido",
+			override:    false,
+		},
+		{
+			tag:         "fieldcontains",
+			translation: "{0} debe contener el valor de {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "fieldexcludes",
+			translation: "{0} no puede contener el valor de {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "file",
+			translation: "{0} debe ser un archivo existente",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0} debe ser un nombre de dominio completo válido",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} debe ser un nombre de host válido (RFC 952)",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} debe ser un host y puerto válidos",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} debe ser un nombre de host válido (RFC 1123)",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} debe contener HTML",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} debe estar codificado en HTML",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0} debe tener su valor por defecto",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} debe ser un código de país ISO 3166-1 alfa-2 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} debe ser un código de país ISO 3166-1 alfa-3 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} debe ser un código de país ISO 3166-1 numérico válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} debe ser un código de subdivisión ISO 3166-2 válido",
+			override:    false,
+		},
+		{
+			tag:         "json",
+			translation: "{0} debe ser una cadena json válida",
+			override:    false,
+		},
+		{
+			tag:         "lowercase",
+			translation: "{0} debe estar en minúsculas",
+			override:    false,
+		},
+		{
+			tag:         "postcode_iso3166_alpha2",
+			translation: "{0} no coincide con el formato de código postal del país {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "postcode_iso3166_alpha2_field",
+			translation: "{0} no coincide con el formato de código postal del país en el campo {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_if",
+			translation: "{0} es un campo requerido",
+			override:    false,
+		},
+		{
+			tag:         "required_unless",
+			translation: "{0} es un campo requerido",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "{0} es requerido cuando {1} está presente",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_with_all",
+			translation: "{0} es requerido cuando todos los campos {1} están presentes",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without",
+			translation: "{0} es requerido cuando {1} está ausente",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without_all",
+			translation: "{0} es requerido cuando todos los campos {1} están ausentes",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startsnotwith",
+			translation: "{0} no puede empezar con el texto '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startswith",
+			translation: "{0} debe empezar con el texto '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} debe ser una zona horaria válida",
+			override:    false,
+		},
+		{
+			tag:         "uppercase",
+			translation: "{0} debe estar en mayúsculas",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} debe estar codificado como URL",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} debe ser un URN válido (RFC 2141)",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} debe ser un UUID versión 3 válido (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} debe ser un UUID versión 4 válido (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} debe ser un UUID versión 5 válido (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} debe ser un UUID válido (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "when",
+			translation: "{0} es un campo requerido",
+			override:    false,
+		},
+		{
+			tag:         "immutable",
+			translation: "{0} no se puede cambiar",
+			override:    false,
+		},
+		{
+			tag:         "transition",
+			translation: "{0} no se puede cambiar a este valor",
+			override:    false,
+		},
+		{
+			tag:         "monotonic",
+			translation: "{0} no puede disminuir",
+			override:    false,
+		},
+		{
+			tag:         "map",
+			translation: "{0} debe ser un objeto o una lista de objetos",
+			override:    false,
+		},
+		{
+			tag:         "variant",
+			translation: "{0} no es un {1} válido",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
 	}
 
 	for _, t := range translations {
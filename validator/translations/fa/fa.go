@@ -1333,6 +1333,445 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					return fe.(error).Error()
 				}
 
+				return t
+			},
+		},
+		{
+			tag:         "type_int",
+			translation: "{0} باید یک عدد صحیح معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "type_uint",
+			translation: "{0} باید یک عدد صحیح نامنفی معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "type_float",
+			translation: "{0} باید یک عدد معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "type_bool",
+			translation: "{0} باید یک مقدار بولی معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "type_time",
+			translation: "{0} باید یک زمان معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "type_duration",
+			translation: "{0} باید یک مدت زمان معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} میتواند فقط شامل حروف و اعداد یونیکد باشد",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} میتواند فقط شامل حروف یونیکد باشد",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} باید یک رشته Base64 URL معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} باید یک برچسب زبان BCP 47 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} باید یک کد BIC (ISO 9362) معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} باید یک آدرس بیت‌کوین معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} باید یک آدرس بیت‌کوین Bech32 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "containsrune",
+			translation: "{0} باید شامل کاراکتر '{1}' باشد",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} باید یک کد کشور معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0} باید یک پوشه موجود باشد",
+			override:    false,
+		},
+		{
+			tag:         "endsnotwith",
+			translation: "{0} نمیتواند با '{1}' تمام شود",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "endswith",
+			translation: "{0} باید با '{1}' تمام شود",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} باید یک آدرس اتریوم معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{0} نباید در صورت وجود {1} مقدار داشته باشد",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{0} نباید در صورت وجود همه فیلدهای {1} مقدار داشته باشد",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{0} نباید در صورت عدم وجود {1} مقدار داشته باشد",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{0} نباید در صورت عدم وجود همه فیلدهای {1} مقدار داشته باشد",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "expr",
+			translation: "{0} معتبر نیست",
+			override:    false,
+		},
+		{
+			tag:         "fieldcontains",
+			translation: "{0} باید شامل مقدار {1} باشد",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "fieldexcludes",
+			translation: "{0} نمیتواند شامل مقدار {1} باشد",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "file",
+			translation: "{0} باید یک فایل موجود باشد",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0} باید یک نام دامنه کامل معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} باید یک نام میزبان معتبر باشد (RFC 952)",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} باید یک میزبان و پورت معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} باید یک نام میزبان معتبر باشد (RFC 1123)",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} باید شامل HTML باشد",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} باید با کدگذاری HTML باشد",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0} باید مقدار پیش‌فرض داشته باشد",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} باید یک کد کشور ISO 3166-1 alpha-2 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} باید یک کد کشور ISO 3166-1 alpha-3 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} باید یک کد عددی کشور ISO 3166-1 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} باید یک کد منطقه ISO 3166-2 معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "required_if",
+			translation: "فیلد {0} اجباری میباشد",
+			override:    false,
+		},
+		{
+			tag:         "required_unless",
+			translation: "فیلد {0} اجباری میباشد",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "فیلد {0} در صورت وجود {1} اجباری میباشد",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_with_all",
+			translation: "فیلد {0} در صورت وجود همه فیلدهای {1} اجباری میباشد",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without",
+			translation: "فیلد {0} در صورت عدم وجود {1} اجباری میباشد",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without_all",
+			translation: "فیلد {0} در صورت عدم وجود همه فیلدهای {1} اجباری میباشد",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startsnotwith",
+			translation: "{0} نمیتواند با '{1}' شروع شود",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startswith",
+			translation: "{0} باید با '{1}' شروع شود",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} باید یک منطقه زمانی معتبر باشد",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} باید با کدگذاری URL باشد",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} باید یک URN معتبر باشد (RFC 2141)",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} باید یک UUID نسخه 3 معتبر باشد (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} باید یک UUID نسخه 4 معتبر باشد (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} باید یک UUID نسخه 5 معتبر باشد (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} باید یک UUID معتبر باشد (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "when",
+			translation: "فیلد {0} اجباری میباشد",
+			override:    false,
+		},
+		{
+			tag:         "immutable",
+			translation: "{0} قابل تغییر نیست",
+			override:    false,
+		},
+		{
+			tag:         "transition",
+			translation: "{0} نمی‌تواند به این مقدار تغییر کند",
+			override:    false,
+		},
+		{
+			tag:         "monotonic",
+			translation: "{0} نمی‌تواند کاهش یابد",
+			override:    false,
+		},
+		{
+			tag:         "map",
+			translation: "{0} باید یک شیء یا لیستی از اشیاء باشد",
+			override:    false,
+		},
+		{
+			tag:         "variant",
+			translation: "{0} یک {1} معتبر نیست",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
 				return t
 			},
 		},
@@ -1311,6 +1311,531 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				return s
 			},
 		},
+		{
+			tag:         "type_int",
+			translation: "{0} doit être un entier valide",
+			override:    false,
+		},
+		{
+			tag:         "type_uint",
+			translation: "{0} doit être un entier positif valide",
+			override:    false,
+		},
+		{
+			tag:         "type_float",
+			translation: "{0} doit être un nombre valide",
+			override:    false,
+		},
+		{
+			tag:         "type_bool",
+			translation: "{0} doit être un booléen valide",
+			override:    false,
+		},
+		{
+			tag:         "type_time",
+			translation: "{0} doit être une date valide",
+			override:    false,
+		},
+		{
+			tag:         "type_duration",
+			translation: "{0} doit être une durée valide",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} ne doit contenir que des caractères alphanumériques unicode",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} ne doit contenir que des caractères alphabétiques unicode",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} doit être une chaîne Base64 URL valide",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} doit être une étiquette de langue BCP 47 valide",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} doit être un code BIC (ISO 9362) valide",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} doit être une adresse Bitcoin valide",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} doit être une adresse Bitcoin Bech32 valide",
+			override:    false,
+		},
+		{
+			tag:         "containsrune",
+			translation: "{0} doit contenir le caractère '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} doit être un code pays valide",
+			override:    false,
+		},
+		{
+			tag:         "datetime",
+			translation: "{0} ne correspond pas au format {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "dir",
+			translation: "{0} doit être un répertoire existant",
+			override:    false,
+		},
+		{
+			tag:         "e164",
+			translation: "{0} doit être un numéro de téléphone valide au format E.164",
+			override:    false,
+		},
+		{
+			tag:         "endsnotwith",
+			translation: "{0} ne doit pas se terminer par le texte '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "endswith",
+			translation: "{0} doit se terminer par le texte '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} doit être une adresse Ethereum valide",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{0} ne doit pas être renseigné lorsque {1} est présent",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{0} ne doit pas être renseigné lorsque tous les champs {1} sont présents",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{0} ne doit pas être renseigné lorsque {1} est absent",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{0} ne doit pas être renseigné lorsque tous les champs {1} sont absents",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "expr",
+			translation: "{0} n'est pas valide",
+			override:    false,
+		},
+		{
+			tag:         "fieldcontains",
+			translation: "{0} doit contenir la valeur de {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "fieldexcludes",
+			translation: "{0} ne doit pas contenir la valeur de {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "file",
+			translation: "{0} doit être un fichier existant",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0} doit être un nom de domaine complet valide",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} doit être un nom d'hôte valide (RFC 952)",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} doit être un hôte et un port valides",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} doit être un nom d'hôte valide (RFC 1123)",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} doit contenir du HTML",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} doit être encodé en HTML",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0} doit avoir sa valeur par défaut",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} doit être un code pays ISO 3166-1 alpha-2 valide",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} doit être un code pays ISO 3166-1 alpha-3 valide",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} doit être un code pays ISO 3166-1 numérique valide",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} doit être un code de subdivision ISO 3166-2 valide",
+			override:    false,
+		},
+		{
+			tag:         "json",
+			translation: "{0} doit être une chaîne json valide",
+			override:    false,
+		},
+		{
+			tag:         "lowercase",
+			translation: "{0} doit être en minuscules",
+			override:    false,
+		},
+		{
+			tag:         "postcode_iso3166_alpha2",
+			translation: "{0} ne correspond pas au format de code postal du pays {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "postcode_iso3166_alpha2_field",
+			translation: "{0} ne correspond pas au format de code postal du pays du champ {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_if",
+			translation: "{0} est un champ obligatoire",
+			override:    false,
+		},
+		{
+			tag:         "required_unless",
+			translation: "{0} est un champ obligatoire",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "{0} est obligatoire lorsque {1} est présent",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_with_all",
+			translation: "{0} est obligatoire lorsque tous les champs {1} sont présents",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without",
+			translation: "{0} est obligatoire lorsque {1} est absent",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without_all",
+			translation: "{0} est obligatoire lorsque tous les champs {1} sont absents",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startsnotwith",
+			translation: "{0} ne doit pas commencer par le texte '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startswith",
+			translation: "{0} doit commencer par le texte '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} doit être un fuseau horaire valide",
+			override:    false,
+		},
+		{
+			tag:         "unique",
+			translation: "{0} doit contenir des valeurs uniques",
+			override:    false,
+		},
+		{
+			tag:         "uppercase",
+			translation: "{0} doit être en majuscules",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} doit être encodé en URL",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} doit être un URN valide (RFC 2141)",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} doit être un UUID version 3 valide (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} doit être un UUID version 4 valide (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} doit être un UUID version 5 valide (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} doit être un UUID valide (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "when",
+			translation: "{0} est un champ obligatoire",
+			override:    false,
+		},
+		{
+			tag:         "immutable",
+			translation: "{0} ne peut pas être modifié",
+			override:    false,
+		},
+		{
+			tag:         "transition",
+			translation: "{0} ne peut pas être changé en cette valeur",
+			override:    false,
+		},
+		{
+			tag:         "monotonic",
+			translation: "{0} ne peut pas diminuer",
+			override:    false,
+		},
+		{
+			tag:         "map",
+			translation: "{0} doit être un objet ou une liste d'objets",
+			override:    false,
+		},
+		{
+			tag:         "variant",
+			translation: "{0} n'est pas un {1} valide",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
 	}
 
 	for _, t := range translations {
@@ -1311,6 +1311,531 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				return s
 			},
 		},
+		{
+			tag:         "type_int",
+			translation: "{0} harus berupa bilangan bulat yang valid",
+			override:    false,
+		},
+		{
+			tag:         "type_uint",
+			translation: "{0} harus berupa bilangan bulat non-negatif yang valid",
+			override:    false,
+		},
+		{
+			tag:         "type_float",
+			translation: "{0} harus berupa angka yang valid",
+			override:    false,
+		},
+		{
+			tag:         "type_bool",
+			translation: "{0} harus berupa nilai boolean yang valid",
+			override:    false,
+		},
+		{
+			tag:         "type_time",
+			translation: "{0} harus berupa waktu yang valid",
+			override:    false,
+		},
+		{
+			tag:         "type_duration",
+			translation: "{0} harus berupa durasi yang valid",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} hanya dapat berisi karakter alfanumerik unicode",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} hanya dapat berisi karakter alfabet unicode",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} harus berupa string Base64 URL yang valid",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} harus berupa tag bahasa BCP 47 yang valid",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} harus berupa kode BIC (ISO 9362) yang valid",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} harus berupa alamat Bitcoin yang valid",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} harus berupa alamat Bitcoin Bech32 yang valid",
+			override:    false,
+		},
+		{
+			tag:         "containsrune",
+			translation: "{0} harus berisi karakter '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} harus berupa kode negara yang valid",
+			override:    false,
+		},
+		{
+			tag:         "datetime",
+			translation: "{0} tidak sesuai dengan format {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "dir",
+			translation: "{0} harus berupa direktori yang ada",
+			override:    false,
+		},
+		{
+			tag:         "e164",
+			translation: "{0} harus berupa nomor telepon yang valid dengan format E.164",
+			override:    false,
+		},
+		{
+			tag:         "endsnotwith",
+			translation: "{0} tidak boleh diakhiri dengan teks '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "endswith",
+			translation: "{0} harus diakhiri dengan teks '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} harus berupa alamat Ethereum yang valid",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{0} tidak boleh diisi jika {1} ada",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{0} tidak boleh diisi jika semua {1} ada",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{0} tidak boleh diisi jika {1} tidak ada",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{0} tidak boleh diisi jika semua {1} tidak ada",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "expr",
+			translation: "{0} tidak valid",
+			override:    false,
+		},
+		{
+			tag:         "fieldcontains",
+			translation: "{0} harus berisi nilai dari {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "fieldexcludes",
+			translation: "{0} tidak boleh berisi nilai dari {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "file",
+			translation: "{0} harus berupa file yang ada",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0} harus berupa nama domain lengkap yang valid",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} harus berupa nama host yang valid (RFC 952)",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} harus berupa host dan port yang valid",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} harus berupa nama host yang valid (RFC 1123)",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} harus berisi HTML",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} harus dikodekan HTML",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0} harus bernilai default",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} harus berupa kode negara ISO 3166-1 alpha-2 yang valid",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} harus berupa kode negara ISO 3166-1 alpha-3 yang valid",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} harus berupa kode negara numerik ISO 3166-1 yang valid",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} harus berupa kode subdivisi ISO 3166-2 yang valid",
+			override:    false,
+		},
+		{
+			tag:         "json",
+			translation: "{0} harus berupa string json yang valid",
+			override:    false,
+		},
+		{
+			tag:         "lowercase",
+			translation: "{0} harus berupa huruf kecil",
+			override:    false,
+		},
+		{
+			tag:         "postcode_iso3166_alpha2",
+			translation: "{0} tidak sesuai dengan format kode pos negara {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "postcode_iso3166_alpha2_field",
+			translation: "{0} tidak sesuai dengan format kode pos negara pada {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_if",
+			translation: "{0} wajib diisi",
+			override:    false,
+		},
+		{
+			tag:         "required_unless",
+			translation: "{0} wajib diisi",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "{0} wajib diisi jika {1} ada",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_with_all",
+			translation: "{0} wajib diisi jika semua {1} ada",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without",
+			translation: "{0} wajib diisi jika {1} tidak ada",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without_all",
+			translation: "{0} wajib diisi jika semua {1} tidak ada",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startsnotwith",
+			translation: "{0} tidak boleh diawali dengan teks '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startswith",
+			translation: "{0} harus diawali dengan teks '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} harus berupa zona waktu yang valid",
+			override:    false,
+		},
+		{
+			tag:         "unique",
+			translation: "{0} harus berisi nilai yang unik",
+			override:    false,
+		},
+		{
+			tag:         "uppercase",
+			translation: "{0} harus berupa huruf besar",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} harus dikodekan URL",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} harus berupa URN yang valid (RFC 2141)",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} harus berupa UUID versi 3 yang valid (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} harus berupa UUID versi 4 yang valid (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} harus berupa UUID versi 5 yang valid (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} harus berupa UUID yang valid (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "when",
+			translation: "{0} wajib diisi",
+			override:    false,
+		},
+		{
+			tag:         "immutable",
+			translation: "{0} tidak dapat diubah",
+			override:    false,
+		},
+		{
+			tag:         "transition",
+			translation: "{0} tidak dapat diubah menjadi nilai ini",
+			override:    false,
+		},
+		{
+			tag:         "monotonic",
+			translation: "{0} tidak boleh berkurang",
+			override:    false,
+		},
+		{
+			tag:         "map",
+			translation: "{0} harus berupa objek atau daftar objek",
+			override:    false,
+		},
+		{
+			tag:         "variant",
+			translation: "{0} bukan {1} yang valid",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
 	}
 
 	for _, t := range translations {
@@ -1367,6 +1367,531 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				return s
 			},
 		},
+		{
+			tag:         "type_int",
+			translation: "{0}は有効な整数でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "type_uint",
+			translation: "{0}は有効な非負の整数でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "type_float",
+			translation: "{0}は有効な数値でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "type_bool",
+			translation: "{0}は有効な真偽値でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "type_time",
+			translation: "{0}は有効な日時でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "type_duration",
+			translation: "{0}は有効な期間でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0}はユニコード英数字のみを含むことができます",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0}はユニコードアルファベットのみを含むことができます",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0}は正しいBase64 URL文字列でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0}は正しいBCP 47言語タグでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0}は正しいBICコード(ISO 9362)でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0}は正しいビットコインアドレスでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0}は正しいBech32ビットコインアドレスでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "containsrune",
+			translation: "{0}は文字'{1}'を含まなければなりません",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "country_code",
+			translation: "{0}は正しい国コードでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "datetime",
+			translation: "{0}は{1}の書式と一致しません",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "dir",
+			translation: "{0}は存在するディレクトリでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "e164",
+			translation: "{0}は正しいE.164形式の電話番号でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "endsnotwith",
+			translation: "{0}は'{1}'で終わることはできません",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "endswith",
+			translation: "{0}は'{1}'で終わらなければなりません",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0}は正しいイーサリアムアドレスでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{1}が存在する場合、{0}は指定できません",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{1}がすべて存在する場合、{0}は指定できません",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{1}が存在しない場合、{0}は指定できません",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{1}がすべて存在しない場合、{0}は指定できません",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "expr",
+			translation: "{0}は無効です",
+			override:    false,
+		},
+		{
+			tag:         "fieldcontains",
+			translation: "{0}は{1}の値を含まなければなりません",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "fieldexcludes",
+			translation: "{0}には{1}の値を含むことはできません",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "file",
+			translation: "{0}は存在するファイルでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0}は正しい完全修飾ドメイン名でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0}は正しいホスト名(RFC 952)でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0}は正しいホストとポートでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0}は正しいホスト名(RFC 1123)でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0}はHTMLを含まなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0}はHTMLエンコードされていなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0}はデフォルト値でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0}は正しいISO 3166-1 alpha-2国コードでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0}は正しいISO 3166-1 alpha-3国コードでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0}は正しいISO 3166-1数字国コードでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0}は正しいISO 3166-2地域コードでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "json",
+			translation: "{0}は正しいJSON文字列でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "lowercase",
+			translation: "{0}は小文字でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "postcode_iso3166_alpha2",
+			translation: "{0}は国{1}の郵便番号の書式と一致しません",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "postcode_iso3166_alpha2_field",
+			translation: "{0}は{1}フィールドの国の郵便番号の書式と一致しません",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_if",
+			translation: "{0}は必須フィールドです",
+			override:    false,
+		},
+		{
+			tag:         "required_unless",
+			translation: "{0}は必須フィールドです",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "{1}が存在する場合、{0}は必須フィールドです",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_with_all",
+			translation: "{1}がすべて存在する場合、{0}は必須フィールドです",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without",
+			translation: "{1}が存在しない場合、{0}は必須フィールドです",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without_all",
+			translation: "{1}がすべて存在しない場合、{0}は必須フィールドです",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startsnotwith",
+			translation: "{0}は'{1}'で始まることはできません",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startswith",
+			translation: "{0}は'{1}'で始まらなければなりません",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "timezone",
+			translation: "{0}は正しいタイムゾーンでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "unique",
+			translation: "{0}は一意な値のみを含まなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "uppercase",
+			translation: "{0}は大文字でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0}はURLエンコードされていなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0}は正しいURN(RFC 2141)でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0}は正しいバージョン3のUUID(RFC 4122)でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0}は正しいバージョン4のUUID(RFC 4122)でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0}は正しいバージョン5のUUID(RFC 4122)でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0}は正しいUUID(RFC 4122)でなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "when",
+			translation: "{0}は必須フィールドです",
+			override:    false,
+		},
+		{
+			tag:         "immutable",
+			translation: "{0}は変更できません",
+			override:    false,
+		},
+		{
+			tag:         "transition",
+			translation: "{0}をこの値に変更することはできません",
+			override:    false,
+		},
+		{
+			tag:         "monotonic",
+			translation: "{0}は減らすことができません",
+			override:    false,
+		},
+		{
+			tag:         "map",
+			translation: "{0}はオブジェクトまたはオブジェクトのリストでなければなりません",
+			override:    false,
+		},
+		{
+			tag:         "variant",
+			translation: "{0}は有効な{1}ではありません",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
 	}
 
 	for _, t := range translations {
@@ -1311,6 +1311,531 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				return s
 			},
 		},
+		{
+			tag:         "type_int",
+			translation: "{0} moet een geldig geheel getal zijn",
+			override:    false,
+		},
+		{
+			tag:         "type_uint",
+			translation: "{0} moet een geldig niet-negatief geheel getal zijn",
+			override:    false,
+		},
+		{
+			tag:         "type_float",
+			translation: "{0} moet een geldig getal zijn",
+			override:    false,
+		},
+		{
+			tag:         "type_bool",
+			translation: "{0} moet een geldige booleaanse waarde zijn",
+			override:    false,
+		},
+		{
+			tag:         "type_time",
+			translation: "{0} moet een geldige tijd zijn",
+			override:    false,
+		},
+		{
+			tag:         "type_duration",
+			translation: "{0} moet een geldige tijdsduur zijn",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} mag alleen unicode alfanumerieke tekens bevatten",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} mag alleen unicode alfabetische tekens bevatten",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} moet een geldige Base64 URL string zijn",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} moet een geldige BCP 47 taaltag zijn",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} moet een geldige BIC code (ISO 9362) zijn",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} moet een geldig Bitcoin adres zijn",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} moet een geldig Bech32 Bitcoin adres zijn",
+			override:    false,
+		},
+		{
+			tag:         "containsrune",
+			translation: "{0} moet het teken '{1}' bevatten",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} moet een geldige landcode zijn",
+			override:    false,
+		},
+		{
+			tag:         "datetime",
+			translation: "{0} komt niet overeen met het formaat {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "dir",
+			translation: "{0} moet een bestaande map zijn",
+			override:    false,
+		},
+		{
+			tag:         "e164",
+			translation: "{0} moet een geldig E.164 telefoonnummer zijn",
+			override:    false,
+		},
+		{
+			tag:         "endsnotwith",
+			translation: "{0} mag niet eindigen met de tekst '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "endswith",
+			translation: "{0} moet eindigen met de tekst '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} moet een geldig Ethereum adres zijn",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{0} mag niet ingevuld zijn als {1} aanwezig is",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{0} mag niet ingevuld zijn als alle velden {1} aanwezig zijn",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{0} mag niet ingevuld zijn als {1} ontbreekt",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{0} mag niet ingevuld zijn als alle velden {1} ontbreken",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "expr",
+			translation: "{0} is ongeldig",
+			override:    false,
+		},
+		{
+			tag:         "fieldcontains",
+			translation: "{0} moet de waarde van {1} bevatten",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "fieldexcludes",
+			translation: "{0} mag niet de waarde van {1} bevatten",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "file",
+			translation: "{0} moet een bestaand bestand zijn",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0} moet een geldige volledige domeinnaam zijn",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} moet een geldige hostnaam zijn (RFC 952)",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} moet een geldige host en poort zijn",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} moet een geldige hostnaam zijn (RFC 1123)",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} moet HTML bevatten",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} moet HTML gecodeerd zijn",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0} moet de standaardwaarde hebben",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} moet een geldige ISO 3166-1 alpha-2 landcode zijn",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} moet een geldige ISO 3166-1 alpha-3 landcode zijn",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} moet een geldige numerieke ISO 3166-1 landcode zijn",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} moet een geldige ISO 3166-2 regiocode zijn",
+			override:    false,
+		},
+		{
+			tag:         "json",
+			translation: "{0} moet een geldige json string zijn",
+			override:    false,
+		},
+		{
+			tag:         "lowercase",
+			translation: "{0} moet in kleine letters zijn",
+			override:    false,
+		},
+		{
+			tag:         "postcode_iso3166_alpha2",
+			translation: "{0} komt niet overeen met het postcodeformaat van land {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "postcode_iso3166_alpha2_field",
+			translation: "{0} komt niet overeen met het postcodeformaat van het land in veld {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_if",
+			translation: "{0} is een verplicht veld",
+			override:    false,
+		},
+		{
+			tag:         "required_unless",
+			translation: "{0} is een verplicht veld",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "{0} is verplicht als {1} aanwezig is",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_with_all",
+			translation: "{0} is verplicht als alle velden {1} aanwezig zijn",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without",
+			translation: "{0} is verplicht als {1} ontbreekt",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without_all",
+			translation: "{0} is verplicht als alle velden {1} ontbreken",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startsnotwith",
+			translation: "{0} mag niet beginnen met de tekst '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startswith",
+			translation: "{0} moet beginnen met de tekst '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} moet een geldige tijdzone zijn",
+			override:    false,
+		},
+		{
+			tag:         "unique",
+			translation: "{0} moet unieke waarden bevatten",
+			override:    false,
+		},
+		{
+			tag:         "uppercase",
+			translation: "{0} moet in hoofdletters zijn",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} moet URL gecodeerd zijn",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} moet een geldige URN zijn (RFC 2141)",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} moet een geldige versie 3 UUID zijn (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} moet een geldige versie 4 UUID zijn (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} moet een geldige versie 5 UUID zijn (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} moet een geldige UUID zijn (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "when",
+			translation: "{0} is een verplicht veld",
+			override:    false,
+		},
+		{
+			tag:         "immutable",
+			translation: "{0} kan niet worden gewijzigd",
+			override:    false,
+		},
+		{
+			tag:         "transition",
+			translation: "{0} kan niet naar deze waarde worden gewijzigd",
+			override:    false,
+		},
+		{
+			tag:         "monotonic",
+			translation: "{0} mag niet afnemen",
+			override:    false,
+		},
+		{
+			tag:         "map",
+			translation: "{0} moet een object of een lijst van objecten zijn",
+			override:    false,
+		},
+		{
+			tag:         "variant",
+			translation: "{0} is geen geldige {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
 	}
 
 	for _, t := range translations {
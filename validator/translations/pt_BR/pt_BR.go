@@ -1311,6 +1311,531 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				return s
 			},
 		},
+		{
+			tag:         "type_int",
+			translation: "{0} deve ser um número inteiro válido",
+			override:    false,
+		},
+		{
+			tag:         "type_uint",
+			translation: "{0} deve ser um número inteiro não negativo válido",
+			override:    false,
+		},
+		{
+			tag:         "type_float",
+			translation: "{0} deve ser um número válido",
+			override:    false,
+		},
+		{
+			tag:         "type_bool",
+			translation: "{0} deve ser um valor booleano válido",
+			override:    false,
+		},
+		{
+			tag:         "type_time",
+			translation: "{0} deve ser uma data/hora válida",
+			override:    false,
+		},
+		{
+			tag:         "type_duration",
+			translation: "{0} deve ser uma duração válida",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} deve conter apenas caracteres alfanuméricos unicode",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} deve conter apenas caracteres alfabéticos unicode",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} deve ser uma string Base64 URL válida",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} deve ser uma etiqueta de idioma BCP 47 válida",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} deve ser um código BIC (ISO 9362) válido",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} deve ser um endereço Bitcoin válido",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} deve ser um endereço Bitcoin Bech32 válido",
+			override:    false,
+		},
+		{
+			tag:         "containsrune",
+			translation: "{0} deve conter o caractere '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} deve ser um código de país válido",
+			override:    false,
+		},
+		{
+			tag:         "datetime",
+			translation: "{0} não está no formato {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "dir",
+			translation: "{0} deve ser um diretório existente",
+			override:    false,
+		},
+		{
+			tag:         "e164",
+			translation: "{0} deve ser um número de telefone válido no formato E.164",
+			override:    false,
+		},
+		{
+			tag:         "endsnotwith",
+			translation: "{0} não deve terminar com o texto '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "endswith",
+			translation: "{0} deve terminar com o texto '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} deve ser um endereço Ethereum válido",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{0} não deve ser preenchido quando {1} está presente",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{0} não deve ser preenchido quando todos os campos {1} estão presentes",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{0} não deve ser preenchido quando {1} está ausente",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{0} não deve ser preenchido quando todos os campos {1} estão ausentes",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "expr",
+			translation: "{0} não é válido",
+			override:    false,
+		},
+		{
+			tag:         "fieldcontains",
+			translation: "{0} deve conter o valor de {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "fieldexcludes",
+			translation: "{0} não deve conter o valor de {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "file",
+			translation: "{0} deve ser um arquivo existente",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0} deve ser um nome de domínio completo válido",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} deve ser um nome de host válido (RFC 952)",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} deve ser um host e porta válidos",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} deve ser um nome de host válido (RFC 1123)",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} deve conter HTML",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} deve estar codificado em HTML",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0} deve ter o valor padrão",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} deve ser um código de país ISO 3166-1 alfa-2 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} deve ser um código de país ISO 3166-1 alfa-3 válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} deve ser um código de país ISO 3166-1 numérico válido",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} deve ser um código de subdivisão ISO 3166-2 válido",
+			override:    false,
+		},
+		{
+			tag:         "json",
+			translation: "{0} deve ser uma string json válida",
+			override:    false,
+		},
+		{
+			tag:         "lowercase",
+			translation: "{0} deve estar em minúsculas",
+			override:    false,
+		},
+		{
+			tag:         "postcode_iso3166_alpha2",
+			translation: "{0} não corresponde ao formato de CEP do país {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "postcode_iso3166_alpha2_field",
+			translation: "{0} não corresponde ao formato de CEP do país no campo {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_if",
+			translation: "{0} é um campo requerido",
+			override:    false,
+		},
+		{
+			tag:         "required_unless",
+			translation: "{0} é um campo requerido",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "{0} é requerido quando {1} está presente",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_with_all",
+			translation: "{0} é requerido quando todos os campos {1} estão presentes",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without",
+			translation: "{0} é requerido quando {1} está ausente",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without_all",
+			translation: "{0} é requerido quando todos os campos {1} estão ausentes",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startsnotwith",
+			translation: "{0} não deve começar com o texto '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startswith",
+			translation: "{0} deve começar com o texto '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} deve ser um fuso horário válido",
+			override:    false,
+		},
+		{
+			tag:         "unique",
+			translation: "{0} deve conter valores únicos",
+			override:    false,
+		},
+		{
+			tag:         "uppercase",
+			translation: "{0} deve estar em maiúsculas",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} deve estar codificado em URL",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} deve ser um URN válido (RFC 2141)",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} deve ser um UUID versão 3 válido (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} deve ser um UUID versão 4 válido (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} deve ser um UUID versão 5 válido (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} deve ser um UUID válido (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "when",
+			translation: "{0} é um campo requerido",
+			override:    false,
+		},
+		{
+			tag:         "immutable",
+			translation: "{0} não pode ser alterado",
+			override:    false,
+		},
+		{
+			tag:         "transition",
+			translation: "{0} não pode ser alterado para este valor",
+			override:    false,
+		},
+		{
+			tag:         "monotonic",
+			translation: "{0} não pode diminuir",
+			override:    false,
+		},
+		{
+			tag:         "map",
+			translation: "{0} deve ser um objeto ou uma lista de objetos",
+			override:    false,
+		},
+		{
+			tag:         "variant",
+			translation: "{0} não é um {1} válido",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("alerta: erro na tradução FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
 	}
 
 	for _, t := range translations {
@@ -1321,6 +1321,521 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				return s
 			},
 		},
+		{
+			tag:         "type_int",
+			translation: "{0} должен быть целым числом",
+			override:    false,
+		},
+		{
+			tag:         "type_uint",
+			translation: "{0} должен быть неотрицательным целым числом",
+			override:    false,
+		},
+		{
+			tag:         "type_float",
+			translation: "{0} должен быть числом",
+			override:    false,
+		},
+		{
+			tag:         "type_bool",
+			translation: "{0} должен быть логическим значением",
+			override:    false,
+		},
+		{
+			tag:         "type_time",
+			translation: "{0} должен быть корректным временем",
+			override:    false,
+		},
+		{
+			tag:         "type_duration",
+			translation: "{0} должен быть корректной продолжительностью",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} может содержать только unicode буквы и цифры",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} может содержать только unicode буквы",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} должен быть Base64 URL строкой",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} должен быть тегом языка BCP 47",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} должен быть BIC кодом (ISO 9362)",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} должен быть Bitcoin адресом",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} должен быть Bech32 Bitcoin адресом",
+			override:    false,
+		},
+		{
+			tag:         "containsrune",
+			translation: "{0} должен содержать символ '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} должен быть кодом страны",
+			override:    false,
+		},
+		{
+			tag:         "datetime",
+			translation: "{0} не соответствует формату {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "dir",
+			translation: "{0} должен быть существующим каталогом",
+			override:    false,
+		},
+		{
+			tag:         "endsnotwith",
+			translation: "{0} не должен заканчиваться текстом '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "endswith",
+			translation: "{0} должен заканчиваться текстом '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} должен быть Ethereum адресом",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{0} не должен быть заполнен, если указано {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{0} не должен быть заполнен, если указаны все поля {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{0} не должен быть заполнен, если не указано {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{0} не должен быть заполнен, если не указано ни одно из полей {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "expr",
+			translation: "{0} имеет недопустимое значение",
+			override:    false,
+		},
+		{
+			tag:         "fieldcontains",
+			translation: "{0} должен содержать значение {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "fieldexcludes",
+			translation: "{0} не должен содержать значение {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "file",
+			translation: "{0} должен быть существующим файлом",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0} должен быть полным доменным именем",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} должен быть именем хоста (RFC 952)",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} должен быть хостом и портом",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} должен быть именем хоста (RFC 1123)",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} должен содержать HTML",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} должен быть закодирован в HTML",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0} должен иметь значение по умолчанию",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} должен быть кодом страны ISO 3166-1 alpha-2",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} должен быть кодом страны ISO 3166-1 alpha-3",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} должен быть цифровым кодом страны ISO 3166-1",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} должен быть кодом региона ISO 3166-2",
+			override:    false,
+		},
+		{
+			tag:         "json",
+			translation: "{0} должен быть json строкой",
+			override:    false,
+		},
+		{
+			tag:         "lowercase",
+			translation: "{0} должен быть строкой в нижнем регистре",
+			override:    false,
+		},
+		{
+			tag:         "postcode_iso3166_alpha2",
+			translation: "{0} не соответствует формату почтового индекса страны {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "postcode_iso3166_alpha2_field",
+			translation: "{0} не соответствует формату почтового индекса страны из поля {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_if",
+			translation: "{0} обязательное поле",
+			override:    false,
+		},
+		{
+			tag:         "required_unless",
+			translation: "{0} обязательное поле",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "{0} обязательное поле, если указано {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_with_all",
+			translation: "{0} обязательное поле, если указаны все поля {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without",
+			translation: "{0} обязательное поле, если не указано {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without_all",
+			translation: "{0} обязательное поле, если не указано ни одно из полей {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startsnotwith",
+			translation: "{0} не должен начинаться с текста '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startswith",
+			translation: "{0} должен начинаться с текста '{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} должен быть часовым поясом",
+			override:    false,
+		},
+		{
+			tag:         "uppercase",
+			translation: "{0} должен быть строкой в верхнем регистре",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} должен быть закодирован в URL",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} должен быть URN (RFC 2141)",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} должен быть UUID версии 3 (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} должен быть UUID версии 4 (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} должен быть UUID версии 5 (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} должен быть UUID (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "when",
+			translation: "{0} обязательное поле",
+			override:    false,
+		},
+		{
+			tag:         "immutable",
+			translation: "{0} не может быть изменен",
+			override:    false,
+		},
+		{
+			tag:         "transition",
+			translation: "{0} не может быть изменен на это значение",
+			override:    false,
+		},
+		{
+			tag:         "monotonic",
+			translation: "{0} не может уменьшаться",
+			override:    false,
+		},
+		{
+			tag:         "map",
+			translation: "{0} должен быть объектом или списком объектов",
+			override:    false,
+		},
+		{
+			tag:         "variant",
+			translation: "{0} не является допустимым {1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
 	}
 
 	for _, t := range translations {
@@ -1316,6 +1316,526 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 				return s
 			},
 		},
+		{
+			tag:         "type_int",
+			translation: "{0} geçerli bir tam sayı olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "type_uint",
+			translation: "{0} geçerli bir negatif olmayan tam sayı olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "type_float",
+			translation: "{0} geçerli bir sayı olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "type_bool",
+			translation: "{0} geçerli bir boolean değer olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "type_time",
+			translation: "{0} geçerli bir zaman olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "type_duration",
+			translation: "{0} geçerli bir süre olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0} yalnızca unicode alfanümerik karakterler içerebilir",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0} yalnızca unicode alfabetik karakterler içerebilir",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0} geçerli bir Base64 URL metni olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0} geçerli bir BCP 47 dil etiketi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0} geçerli bir BIC kodu (ISO 9362) olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0} geçerli bir Bitcoin adresi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0} geçerli bir Bech32 Bitcoin adresi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "containsrune",
+			translation: "{0}, '{1}' karakterini içermelidir",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "country_code",
+			translation: "{0} geçerli bir ülke kodu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "datetime",
+			translation: "{0}, {1} biçimine uymuyor",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "dir",
+			translation: "{0} mevcut bir dizin olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "e164",
+			translation: "{0} geçerli bir E.164 formatında telefon numarası olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "endsnotwith",
+			translation: "{0}, '{1}' metniyle bitemez",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "endswith",
+			translation: "{0}, '{1}' metniyle bitmelidir",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0} geçerli bir Ethereum adresi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{1} mevcutken {0} belirtilmemelidir",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{1} alanlarının tümü mevcutken {0} belirtilmemelidir",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{1} yokken {0} belirtilmemelidir",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{1} alanlarının hiçbiri yokken {0} belirtilmemelidir",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "expr",
+			translation: "{0} geçersiz",
+			override:    false,
+		},
+		{
+			tag:         "fieldcontains",
+			translation: "{0}, {1} değerini içermelidir",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "fieldexcludes",
+			translation: "{0}, {1} değerini içeremez",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "file",
+			translation: "{0} mevcut bir dosya olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0} geçerli bir tam nitelikli alan adı olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0} geçerli bir ana bilgisayar adı olmalıdır (RFC 952)",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0} geçerli bir ana bilgisayar ve port olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0} geçerli bir ana bilgisayar adı olmalıdır (RFC 1123)",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0} HTML içermelidir",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0} HTML kodlanmış olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0} varsayılan değerinde olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0} geçerli bir ISO 3166-1 alpha-2 ülke kodu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0} geçerli bir ISO 3166-1 alpha-3 ülke kodu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0} geçerli bir ISO 3166-1 sayısal ülke kodu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0} geçerli bir ISO 3166-2 bölge kodu olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "json",
+			translation: "{0} geçerli bir json metni olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "lowercase",
+			translation: "{0} küçük harf olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "postcode_iso3166_alpha2",
+			translation: "{0}, {1} ülkesinin posta kodu biçimine uymuyor",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "postcode_iso3166_alpha2_field",
+			translation: "{0}, {1} alanındaki ülkenin posta kodu biçimine uymuyor",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_if",
+			translation: "{0} zorunlu bir alandır",
+			override:    false,
+		},
+		{
+			tag:         "required_unless",
+			translation: "{0} zorunlu bir alandır",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "{1} mevcutken {0} zorunludur",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_with_all",
+			translation: "{1} alanlarının tümü mevcutken {0} zorunludur",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without",
+			translation: "{1} yokken {0} zorunludur",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without_all",
+			translation: "{1} alanlarının hiçbiri yokken {0} zorunludur",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startsnotwith",
+			translation: "{0}, '{1}' metniyle başlayamaz",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startswith",
+			translation: "{0}, '{1}' metniyle başlamalıdır",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "timezone",
+			translation: "{0} geçerli bir saat dilimi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "uppercase",
+			translation: "{0} büyük harf olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0} URL kodlanmış olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0} geçerli bir URN olmalıdır (RFC 2141)",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0} geçerli bir sürüm 3 UUID olmalıdır (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0} geçerli bir sürüm 4 UUID olmalıdır (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0} geçerli bir sürüm 5 UUID olmalıdır (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0} geçerli bir UUID olmalıdır (RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "when",
+			translation: "{0} zorunlu bir alandır",
+			override:    false,
+		},
+		{
+			tag:         "immutable",
+			translation: "{0} değiştirilemez",
+			override:    false,
+		},
+		{
+			tag:         "transition",
+			translation: "{0} bu değere değiştirilemez",
+			override:    false,
+		},
+		{
+			tag:         "monotonic",
+			translation: "{0} azaltılamaz",
+			override:    false,
+		},
+		{
+			tag:         "map",
+			translation: "{0} bir nesne veya nesne listesi olmalıdır",
+			override:    false,
+		},
+		{
+			tag:         "variant",
+			translation: "{0} geçerli bir {1} değil",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("warning: error translating FieldError: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
 	}
 
 	for _, t := range translations {
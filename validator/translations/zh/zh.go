@@ -1335,6 +1335,501 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					return fe.(error).Error()
 				}
 
+				return t
+			},
+		},
+		{
+			tag:         "type_int",
+			translation: "{0}必须是有效的整数",
+			override:    false,
+		},
+		{
+			tag:         "type_uint",
+			translation: "{0}必须是有效的非负整数",
+			override:    false,
+		},
+		{
+			tag:         "type_float",
+			translation: "{0}必须是有效的数字",
+			override:    false,
+		},
+		{
+			tag:         "type_bool",
+			translation: "{0}必须是有效的布尔值",
+			override:    false,
+		},
+		{
+			tag:         "type_time",
+			translation: "{0}必须是有效的时间",
+			override:    false,
+		},
+		{
+			tag:         "type_duration",
+			translation: "{0}必须是有效的时长",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0}只能包含unicode字母和数字",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0}只能包含unicode字母",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0}必须是一个有效的Base64 URL字符串",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0}必须是一个有效的BCP 47语言标签",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0}必须是一个有效的BIC代码(ISO 9362)",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0}必须是一个有效的比特币地址",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0}必须是一个有效的Bech32比特币地址",
+			override:    false,
+		},
+		{
+			tag:         "containsrune",
+			translation: "{0}必须包含字符'{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻译字段错误: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "country_code",
+			translation: "{0}必须是一个有效的国家代码",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0}必须是一个存在的目录",
+			override:    false,
+		},
+		{
+			tag:         "e164",
+			translation: "{0}必须是一个有效的E.164格式的电话号码",
+			override:    false,
+		},
+		{
+			tag:         "endsnotwith",
+			translation: "{0}不能以文本'{1}'结尾",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻译字段错误: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "endswith",
+			translation: "{0}必须以文本'{1}'结尾",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻译字段错误: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0}必须是一个有效的以太坊地址",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{1}存在时{0}不能有值",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻译字段错误: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{1}都存在时{0}不能有值",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻译字段错误: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{1}不存在时{0}不能有值",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻译字段错误: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{1}都不存在时{0}不能有值",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻译字段错误: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "expr",
+			translation: "{0}无效",
+			override:    false,
+		},
+		{
+			tag:         "fieldcontains",
+			translation: "{0}必须包含{1}的值",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻译字段错误: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "fieldexcludes",
+			translation: "{0}不能包含{1}的值",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻译字段错误: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "file",
+			translation: "{0}必须是一个存在的文件",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0}必须是一个有效的完全限定域名",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0}必须是一个有效的主机名(RFC 952)",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0}必须是一个有效的主机和端口",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0}必须是一个有效的主机名(RFC 1123)",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0}必须包含HTML",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0}必须是HTML编码的",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0}必须是默认值",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0}必须是一个有效的ISO 3166-1 alpha-2国家代码",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0}必须是一个有效的ISO 3166-1 alpha-3国家代码",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0}必须是一个有效的ISO 3166-1数字国家代码",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0}必须是一个有效的ISO 3166-2地区代码",
+			override:    false,
+		},
+		{
+			tag:         "postcode_iso3166_alpha2",
+			translation: "{0}不符合国家{1}的邮政编码格式",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻译字段错误: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "postcode_iso3166_alpha2_field",
+			translation: "{0}不符合{1}字段中国家的邮政编码格式",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻译字段错误: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_if",
+			translation: "{0}为必填字段",
+			override:    false,
+		},
+		{
+			tag:         "required_unless",
+			translation: "{0}为必填字段",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "{1}存在时{0}为必填字段",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻译字段错误: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_with_all",
+			translation: "{1}都存在时{0}为必填字段",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻译字段错误: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without",
+			translation: "{1}不存在时{0}为必填字段",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻译字段错误: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without_all",
+			translation: "{1}都不存在时{0}为必填字段",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻译字段错误: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startsnotwith",
+			translation: "{0}不能以文本'{1}'开头",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻译字段错误: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startswith",
+			translation: "{0}必须以文本'{1}'开头",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻译字段错误: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "timezone",
+			translation: "{0}必须是一个有效的时区",
+			override:    false,
+		},
+		{
+			tag:         "unique",
+			translation: "{0}必须包含唯一的值",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0}必须是URL编码的",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0}必须是一个有效的URN(RFC 2141)",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0}必须是一个有效的V3 UUID(RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0}必须是一个有效的V4 UUID(RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0}必须是一个有效的V5 UUID(RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0}必须是一个有效的UUID(RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "when",
+			translation: "{0}为必填字段",
+			override:    false,
+		},
+		{
+			tag:         "immutable",
+			translation: "{0}不能被修改",
+			override:    false,
+		},
+		{
+			tag:         "transition",
+			translation: "{0}不能修改为该值",
+			override:    false,
+		},
+		{
+			tag:         "monotonic",
+			translation: "{0}不能减少",
+			override:    false,
+		},
+		{
+			tag:         "map",
+			translation: "{0}必须是对象或对象列表",
+			override:    false,
+		},
+		{
+			tag:         "variant",
+			translation: "{0}不是有效的{1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻译字段错误: %#v", fe)
+					return fe.(error).Error()
+				}
+
 				return t
 			},
 		},
@@ -659,3 +659,34 @@ func TestTranslations(t *testing.T) {
 	}
 
 }
+
+func TestFieldLabels(t *testing.T) {
+
+	zh := zhongwen.New()
+	uni := ut.New(zh, zh)
+	trans, _ := uni.GetTranslator("zh")
+
+	validate := validator.New()
+
+	err := RegisterDefaultTranslations(validate, trans)
+	Equal(t, err, nil)
+
+	type Account struct {
+		Email    string `validate:"required,email"`
+		Password string `validate:"required"`
+		Confirm  string `validate:"eqfield=Password"`
+	}
+
+	err = validate.RegisterFieldLabels(trans, Account{}, map[string]string{
+		"Email":    "邮箱",
+		"Password": "密码",
+		"Confirm":  "确认密码",
+	})
+	Equal(t, err, nil)
+
+	errs := validate.Struct(Account{Confirm: "x"}).(validator.ValidationErrors)
+	Equal(t, len(errs), 3)
+	Equal(t, errs[0].Translate(trans), "邮箱为必填字段")
+	Equal(t, errs[1].Translate(trans), "密码为必填字段")
+	Equal(t, errs[2].Translate(trans), "确认密码必须等于密码")
+}
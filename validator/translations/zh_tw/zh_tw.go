@@ -1316,6 +1316,516 @@ func RegisterDefaultTranslations(v *validator.Validate, trans ut.Translator) (er
 					return fe.(error).Error()
 				}
 
+				return t
+			},
+		},
+		{
+			tag:         "type_int",
+			translation: "{0}必須是有效的整數",
+			override:    false,
+		},
+		{
+			tag:         "type_uint",
+			translation: "{0}必須是有效的非負整數",
+			override:    false,
+		},
+		{
+			tag:         "type_float",
+			translation: "{0}必須是有效的數字",
+			override:    false,
+		},
+		{
+			tag:         "type_bool",
+			translation: "{0}必須是有效的布林值",
+			override:    false,
+		},
+		{
+			tag:         "type_time",
+			translation: "{0}必須是有效的時間",
+			override:    false,
+		},
+		{
+			tag:         "type_duration",
+			translation: "{0}必須是有效的時長",
+			override:    false,
+		},
+		{
+			tag:         "alphanumunicode",
+			translation: "{0}只能包含unicode字母和數字",
+			override:    false,
+		},
+		{
+			tag:         "alphaunicode",
+			translation: "{0}只能包含unicode字母",
+			override:    false,
+		},
+		{
+			tag:         "base64url",
+			translation: "{0}必須是一個有效的Base64 URL字串",
+			override:    false,
+		},
+		{
+			tag:         "bcp47_language_tag",
+			translation: "{0}必須是一個有效的BCP 47語言標籤",
+			override:    false,
+		},
+		{
+			tag:         "bic",
+			translation: "{0}必須是一個有效的BIC代碼(ISO 9362)",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr",
+			translation: "{0}必須是一個有效的比特幣地址",
+			override:    false,
+		},
+		{
+			tag:         "btc_addr_bech32",
+			translation: "{0}必須是一個有效的Bech32比特幣地址",
+			override:    false,
+		},
+		{
+			tag:         "containsrune",
+			translation: "{0}必須包含字元'{1}'",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "country_code",
+			translation: "{0}必須是一個有效的國家代碼",
+			override:    false,
+		},
+		{
+			tag:         "dir",
+			translation: "{0}必須是一個存在的目錄",
+			override:    false,
+		},
+		{
+			tag:         "e164",
+			translation: "{0}必須是一個有效的E.164格式的電話號碼",
+			override:    false,
+		},
+		{
+			tag:         "endsnotwith",
+			translation: "{0}不能以文字'{1}'結尾",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "endswith",
+			translation: "{0}必須以文字'{1}'結尾",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "eth_addr",
+			translation: "{0}必須是一個有效的以太坊地址",
+			override:    false,
+		},
+		{
+			tag:         "excluded_with",
+			translation: "{1}存在時{0}不能有值",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_with_all",
+			translation: "{1}都存在時{0}不能有值",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without",
+			translation: "{1}不存在時{0}不能有值",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "excluded_without_all",
+			translation: "{1}都不存在時{0}不能有值",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "expr",
+			translation: "{0}無效",
+			override:    false,
+		},
+		{
+			tag:         "fieldcontains",
+			translation: "{0}必須包含{1}的值",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "fieldexcludes",
+			translation: "{0}不能包含{1}的值",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "file",
+			translation: "{0}必須是一個存在的檔案",
+			override:    false,
+		},
+		{
+			tag:         "fqdn",
+			translation: "{0}必須是一個有效的完整網域名稱",
+			override:    false,
+		},
+		{
+			tag:         "hostname",
+			translation: "{0}必須是一個有效的主機名稱(RFC 952)",
+			override:    false,
+		},
+		{
+			tag:         "hostname_port",
+			translation: "{0}必須是一個有效的主機和連接埠",
+			override:    false,
+		},
+		{
+			tag:         "hostname_rfc1123",
+			translation: "{0}必須是一個有效的主機名稱(RFC 1123)",
+			override:    false,
+		},
+		{
+			tag:         "html",
+			translation: "{0}必須包含HTML",
+			override:    false,
+		},
+		{
+			tag:         "html_encoded",
+			translation: "{0}必須是HTML編碼的",
+			override:    false,
+		},
+		{
+			tag:         "isdefault",
+			translation: "{0}必須是預設值",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha2",
+			translation: "{0}必須是一個有效的ISO 3166-1 alpha-2國家代碼",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha3",
+			translation: "{0}必須是一個有效的ISO 3166-1 alpha-3國家代碼",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_1_alpha_numeric",
+			translation: "{0}必須是一個有效的ISO 3166-1數字國家代碼",
+			override:    false,
+		},
+		{
+			tag:         "iso3166_2",
+			translation: "{0}必須是一個有效的ISO 3166-2地區代碼",
+			override:    false,
+		},
+		{
+			tag:         "json",
+			translation: "{0}必須是一個JSON字串",
+			override:    false,
+		},
+		{
+			tag:         "lowercase",
+			translation: "{0}必須是小寫字母",
+			override:    false,
+		},
+		{
+			tag:         "postcode_iso3166_alpha2",
+			translation: "{0}不符合國家{1}的郵遞區號格式",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "postcode_iso3166_alpha2_field",
+			translation: "{0}不符合{1}欄位中國家的郵遞區號格式",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_if",
+			translation: "{0}為必填欄位",
+			override:    false,
+		},
+		{
+			tag:         "required_unless",
+			translation: "{0}為必填欄位",
+			override:    false,
+		},
+		{
+			tag:         "required_with",
+			translation: "{1}存在時{0}為必填欄位",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_with_all",
+			translation: "{1}都存在時{0}為必填欄位",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without",
+			translation: "{1}不存在時{0}為必填欄位",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "required_without_all",
+			translation: "{1}都不存在時{0}為必填欄位",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startsnotwith",
+			translation: "{0}不能以文字'{1}'開頭",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "startswith",
+			translation: "{0}必須以文字'{1}'開頭",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
+					return fe.(error).Error()
+				}
+
+				return t
+			},
+		},
+		{
+			tag:         "timezone",
+			translation: "{0}必須是一個有效的時區",
+			override:    false,
+		},
+		{
+			tag:         "unique",
+			translation: "{0}必須包含唯一的值",
+			override:    false,
+		},
+		{
+			tag:         "uppercase",
+			translation: "{0}必須是大寫字母",
+			override:    false,
+		},
+		{
+			tag:         "url_encoded",
+			translation: "{0}必須是URL編碼的",
+			override:    false,
+		},
+		{
+			tag:         "urn_rfc2141",
+			translation: "{0}必須是一個有效的URN(RFC 2141)",
+			override:    false,
+		},
+		{
+			tag:         "uuid3_rfc4122",
+			translation: "{0}必須是一個有效的V3 UUID(RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid4_rfc4122",
+			translation: "{0}必須是一個有效的V4 UUID(RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid5_rfc4122",
+			translation: "{0}必須是一個有效的V5 UUID(RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "uuid_rfc4122",
+			translation: "{0}必須是一個有效的UUID(RFC 4122)",
+			override:    false,
+		},
+		{
+			tag:         "when",
+			translation: "{0}為必填欄位",
+			override:    false,
+		},
+		{
+			tag:         "immutable",
+			translation: "{0}不能被修改",
+			override:    false,
+		},
+		{
+			tag:         "transition",
+			translation: "{0}不能修改為該值",
+			override:    false,
+		},
+		{
+			tag:         "monotonic",
+			translation: "{0}不能減少",
+			override:    false,
+		},
+		{
+			tag:         "map",
+			translation: "{0}必須是物件或物件列表",
+			override:    false,
+		},
+		{
+			tag:         "variant",
+			translation: "{0}不是有效的{1}",
+			override:    false,
+			customTransFunc: func(ut ut.Translator, fe validator.FieldError) string {
+
+				t, err := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				if err != nil {
+					log.Printf("警告: 翻譯欄位錯誤: %#v", fe)
+					return fe.(error).Error()
+				}
+
 				return t
 			},
 		},
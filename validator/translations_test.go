@@ -0,0 +1,83 @@
+package validator_test
+
+import (
+	"sort"
+	"testing"
+
+	"frames/validator"
+	"frames/validator/translations/en"
+	"frames/validator/translations/es"
+	"frames/validator/translations/fa"
+	"frames/validator/translations/fr"
+	"frames/validator/translations/id"
+	"frames/validator/translations/ja"
+	"frames/validator/translations/nl"
+	"frames/validator/translations/pt"
+	"frames/validator/translations/pt_BR"
+	"frames/validator/translations/ru"
+	"frames/validator/translations/tr"
+	"frames/validator/translations/zh"
+	"frames/validator/translations/zh_tw"
+	"github.com/go-playground/locales"
+	english "github.com/go-playground/locales/en"
+	spanish "github.com/go-playground/locales/es"
+	persian "github.com/go-playground/locales/fa"
+	french "github.com/go-playground/locales/fr"
+	indonesian "github.com/go-playground/locales/id"
+	japanese "github.com/go-playground/locales/ja"
+	dutch "github.com/go-playground/locales/nl"
+	portuguese "github.com/go-playground/locales/pt"
+	brazilian_portuguese "github.com/go-playground/locales/pt_BR"
+	russian "github.com/go-playground/locales/ru"
+	turkish "github.com/go-playground/locales/tr"
+	chinese "github.com/go-playground/locales/zh"
+	chinese_tw "github.com/go-playground/locales/zh_Hant_TW"
+	ut "github.com/go-playground/universal-translator"
+)
+
+// TestTranslationCoverage fails when a baked in tag has no translation in one
+// of the locales in translations.
+func TestTranslationCoverage(t *testing.T) {
+
+	tests := []struct {
+		locale   locales.Translator
+		register func(*validator.Validate, ut.Translator) error
+	}{
+		{english.New(), en.RegisterDefaultTranslations},
+		{spanish.New(), es.RegisterDefaultTranslations},
+		{persian.New(), fa.RegisterDefaultTranslations},
+		{french.New(), fr.RegisterDefaultTranslations},
+		{indonesian.New(), id.RegisterDefaultTranslations},
+		{japanese.New(), ja.RegisterDefaultTranslations},
+		{dutch.New(), nl.RegisterDefaultTranslations},
+		{portuguese.New(), pt.RegisterDefaultTranslations},
+		{brazilian_portuguese.New(), pt_BR.RegisterDefaultTranslations},
+		{russian.New(), ru.RegisterDefaultTranslations},
+		{turkish.New(), tr.RegisterDefaultTranslations},
+		{chinese.New(), zh.RegisterDefaultTranslations},
+		{chinese_tw.New(), zh_tw.RegisterDefaultTranslations},
+	}
+
+	tags := validator.BakedInTags()
+	sort.Strings(tags)
+
+	for _, tt := range tests {
+		uni := ut.New(tt.locale, tt.locale)
+		trans, _ := uni.GetTranslator(tt.locale.Locale())
+
+		validate := validator.New()
+		if err := tt.register(validate, trans); err != nil {
+			t.Fatalf("%s: %v", tt.locale.Locale(), err)
+		}
+
+		var missing []string
+		for _, tag := range tags {
+			if !validator.HasTranslation(validate, trans, tag) {
+				missing = append(missing, tag)
+			}
+		}
+		if len(missing) > 0 {
+			t.Errorf("%s: %d tags without translation: %v", tt.locale.Locale(), len(missing), missing)
+		}
+	}
+}
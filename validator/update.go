@@ -0,0 +1,292 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const transitionSeparator = ">"
+
+// transition is an allowed change of the transition tag, '*' matching any
+// value.
+type transition struct {
+	from string
+	to   string
+}
+
+// StructUpdate validates new, the incoming value of a struct, like StructCtx
+// while giving the immutable, transition and monotonic tags access to the value
+// of the same field in old, the stored value, eg.
+//
+//	type Post struct {
+//		CreatedBy string `validate:"required,immutable"`
+//		Status    string `validate:"transition=draft>published|published>archived"`
+//		Revision  int    `validate:"monotonic"`
+//	}
+//
+//	err := validate.StructUpdate(ctx, stored, incoming)
+//
+// old and new must be, or point to, structs of the same type. Errors have the
+// namespace of the field of new, as with StructCtx.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructUpdate(ctx context.Context, old interface{}, new interface{}) (err error) {
+
+	oldVal := reflect.ValueOf(old)
+	if oldVal.Kind() == reflect.Ptr && !oldVal.IsNil() {
+		oldVal = oldVal.Elem()
+	}
+
+	if oldVal.Kind() != reflect.Struct {
+		return &InvalidValidationError{Type: reflect.TypeOf(old)}
+	}
+
+	return v.structCtx(ctx, new, nil, oldVal)
+}
+
+// oldFieldOf returns the old value of the field under validation, found by its
+// namespace in the old value of StructUpdate or VarWithValue. ok is false when
+// there is no old value, eg. when validating with Struct, or the field was
+// not set in it.
+func oldFieldOf(fl FieldLevel) (old reflect.Value, kind reflect.Kind, ok bool) {
+
+	vd, isValidate := fl.(*validate)
+	if !isValidate || !vd.old.IsValid() {
+		return
+	}
+
+	ns := string(vd.flNs) + vd.cf.name
+
+	typ := vd.old.Type()
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	// the namespaces of a named top level struct are prefixed by its name
+	if typ.Kind() == reflect.Struct && len(typ.Name()) > 0 {
+		ns = strings.TrimPrefix(ns, typ.Name()+namespaceSeparator)
+	}
+
+	old, kind, _, ok = vd.getStructFieldOKInternal(vd.old, ns)
+	if kind == reflect.Invalid {
+		ok = false
+	}
+	return
+}
+
+// isImmutable is the validation function for validating that the field has not
+// changed from its old value.
+func isImmutable(fl FieldLevel) bool {
+	old, kind, ok := oldFieldOf(fl)
+	if !ok {
+		return true
+	}
+
+	field := fl.Field()
+	if kind != field.Kind() {
+		return false
+	}
+
+	switch kind {
+	case reflect.Ptr, reflect.Interface:
+		// both are nil
+		return true
+	}
+
+	if field.Type() != old.Type() {
+		return false
+	}
+
+	if field.Type() == timeType {
+		return field.Interface().(time.Time).Equal(old.Interface().(time.Time))
+	}
+	return reflect.DeepEqual(field.Interface(), old.Interface())
+}
+
+// compileTransitions parses param, '|' separated transitions written old>new.
+// pos is the offset within param of the transition which cannot be parsed.
+func compileTransitions(param string) (ts []transition, pos int, err error) {
+	if len(param) == 0 {
+		return nil, 0, errors.New("missing transitions")
+	}
+
+	for _, t := range strings.Split(param, "|") {
+		idx := strings.Index(t, transitionSeparator)
+		if idx == -1 {
+			return nil, pos, fmt.Errorf("missing '%s' in transition '%s'", transitionSeparator, t)
+		}
+		ts = append(ts, transition{from: strings.TrimSpace(t[:idx]), to: strings.TrimSpace(t[idx+1:])})
+		pos += len(t) + 1
+	}
+	return ts, 0, nil
+}
+
+// transitionsOf returns the compiled transitions of the current tag.
+func transitionsOf(fl FieldLevel) []transition {
+	if vd, ok := fl.(*validate); ok && vd.ct != nil && vd.ct.transitions != nil {
+		return vd.ct.transitions
+	}
+	ts, _, err := compileTransitions(fl.Param())
+	if err != nil {
+		panic(fmt.Sprintf("Invalid transition param '%s' on field '%s': %s", fl.Param(), fl.FieldName(), err))
+	}
+	return ts
+}
+
+// isTransition is the validation function for validating that the field has
+// not changed from its old value, or has changed as one of the transitions of
+// the param allows, eg. draft>published. Values are compared formatted with
+// fmt.Sprint.
+func isTransition(fl FieldLevel) bool {
+	old, kind, ok := oldFieldOf(fl)
+	if !ok || kind == reflect.Ptr || kind == reflect.Interface {
+		// the field had no value, so any is its initial one
+		return true
+	}
+
+	from, to := fmt.Sprint(old.Interface()), ""
+
+	field := fl.Field()
+	switch field.Kind() {
+	case reflect.Ptr, reflect.Interface:
+	default:
+		to = fmt.Sprint(field.Interface())
+	}
+
+	if from == to {
+		return true
+	}
+
+	for _, t := range transitionsOf(fl) {
+		if (t.from == "*" || t.from == from) && (t.to == "*" || t.to == to) {
+			return true
+		}
+	}
+	return false
+}
+
+// isMonotonic is the validation function for validating that the field has
+// not decreased from its old value. Numbers and time.Time are compared by
+// value, slices, arrays and maps by length; other types are rejected when the
+// struct is cached, see checkMonotonic.
+func isMonotonic(fl FieldLevel) bool {
+	old, kind, ok := oldFieldOf(fl)
+	if !ok || kind == reflect.Ptr || kind == reflect.Interface {
+		return true
+	}
+
+	field := fl.Field()
+	switch field.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return false
+	}
+
+	if field.Type() != old.Type() {
+		return false
+	}
+
+	switch field.Kind() {
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return field.Int() >= old.Int()
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return field.Uint() >= old.Uint()
+
+	case reflect.Float32, reflect.Float64:
+		return field.Float() >= old.Float()
+
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return field.Len() >= old.Len()
+
+	case reflect.Struct:
+		if field.Type() == timeType {
+			return !field.Interface().(time.Time).Before(old.Interface().(time.Time))
+		}
+	}
+
+	panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+}
+
+// checkMonotonic panics with a TagSyntaxError if a monotonic tag in tags is
+// used on values of typ, the type of the field, which cannot be compared.
+// Values within interfaces or having a custom type func are only known when
+// validating.
+func (v *Validate) checkMonotonic(r *registry, tags []*tagExpr, typ reflect.Type, fieldName string) {
+
+	var keyType, elemType reflect.Type
+
+	for _, e := range tags {
+		if typ == nil {
+			return
+		}
+
+		if e.kind != exprTag {
+			v.checkMonotonic(r, e.args, typ, fieldName)
+			continue
+		}
+
+		if tagsVal, found := r.aliases[e.name]; found && !e.hasParam {
+			v.checkMonotonic(r, parseTag(tagsVal, fieldName), typ, fieldName)
+			continue
+		}
+
+		switch e.name {
+		case diveTag:
+			for typ.Kind() == reflect.Ptr {
+				typ = typ.Elem()
+			}
+			switch typ.Kind() {
+			case reflect.Slice, reflect.Array:
+				typ = typ.Elem()
+			case reflect.Map:
+				keyType, typ = typ.Key(), typ.Elem()
+			default:
+				typ = nil
+			}
+
+		case keysTag:
+			elemType, typ = typ, keyType
+
+		case endKeysTag:
+			typ = elemType
+
+		case monotonicTag:
+			if !isMonotonicType(r, typ) {
+				panic(&TagSyntaxError{
+					Field:  fieldName,
+					Tag:    e.src,
+					Column: e.pos + 1,
+					Msg:    fmt.Sprintf("'%s' cannot be used on values of type %s", monotonicTag, typ),
+				})
+			}
+		}
+	}
+}
+
+// isMonotonicType reports whether isMonotonic can compare values of typ.
+func isMonotonicType(r *registry, typ reflect.Type) bool {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if _, ok := r.customFuncs[typ]; ok {
+		return true
+	}
+
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Slice, reflect.Array, reflect.Map, reflect.Interface:
+		return true
+	case reflect.Struct:
+		return typ == timeType
+	}
+	return false
+}
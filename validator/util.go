@@ -41,9 +41,9 @@ BEGIN:
 
 	default:
 
-		if v.v.hasCustomFuncs {
+		if len(v.r.customFuncs) > 0 {
 
-			if fn, ok := v.v.customFuncs[current.Type()]; ok {
+			if fn, ok := v.r.customFuncs[current.Type()]; ok {
 				current = reflect.ValueOf(fn(current))
 				goto BEGIN
 			}
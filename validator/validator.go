@@ -10,15 +10,24 @@ import (
 // per validate construct
 type validate struct {
 	v              *Validate
+	r              *registry    // registry the validation started with
+	sc             *structCache // struct cache of the validation groups in use
 	top            reflect.Value
 	ns             []byte
 	actualNs       []byte
 	errs           ValidationErrors
+	maxErrs        int                 // stop once len(errs) reaches it, 0 means unlimited
+	ctxCheckEvery  int                 // check ctx.Err() every ctxCheckEvery fields, 0 disables it
+	ctxSteps       int                 // fields traversed, for ctxCheckEvery
+	ctxErr         error               // ctx.Err() once the context is done
+	derefIfaces    bool                // validate non-nil interfaces as the value they hold, set by Map
 	includeExclude map[string]struct{} // reset only if StructPartial or StructExcept are called, no need otherwise
 	ffn            FilterFunc
+	old            reflect.Value // the old value of StructUpdate and VarWithValue, for immutable, transition and monotonic
 	slflParent     reflect.Value // StructLevel & FieldLevel
 	slCurrent      reflect.Value // StructLevel & FieldLevel
 	flField        reflect.Value // StructLevel & FieldLevel
+	flNs           []byte        // FieldLevel, the struct namespace of the field's parent
 	cf             *cField       // StructLevel & FieldLevel
 	ct             *cTag         // StructLevel & FieldLevel
 	misc           []byte        // misc reusable
@@ -32,11 +41,14 @@ type validate struct {
 // parent and current will be the same the first run of validateStruct
 func (v *validate) validateStruct(ctx context.Context, parent reflect.Value, current reflect.Value, typ reflect.Type, ns []byte, structNs []byte, ct *cTag) {
 
-	cs, ok := v.v.structCache.Get(typ)
+	cs, ok := v.sc.Get(typ)
 	if !ok {
-		cs = v.v.extractStructCache(current, typ.Name())
+		cs = v.v.extractStructCache(v.r, v.sc, current, typ.Name())
 	}
 
+	// whether this is the top level struct, whose name prefixes the namespaces
+	top := false
+
 	if len(ns) == 0 && len(cs.name) != 0 {
 
 		ns = append(ns, cs.name...)
@@ -44,6 +56,8 @@ func (v *validate) validateStruct(ctx context.Context, parent reflect.Value, cur
 
 		structNs = append(structNs, cs.name...)
 		structNs = append(structNs, '.')
+
+		top = true
 	}
 
 	// ct is nil on top level struct, and structs as fields that have no tag info
@@ -54,6 +68,10 @@ func (v *validate) validateStruct(ctx context.Context, parent reflect.Value, cur
 
 		for i := 0; i < len(cs.fields); i++ {
 
+			if v.isDone() {
+				break
+			}
+
 			f = cs.fields[i]
 
 			if v.isPartial {
@@ -74,6 +92,11 @@ func (v *validate) validateStruct(ctx context.Context, parent reflect.Value, cur
 				}
 			}
 
+			if f.variants != nil {
+				v.traverseVariant(ctx, current, current.Field(f.idx), ns, structNs, f)
+				continue
+			}
+
 			v.traverseField(ctx, current, current.Field(f.idx), ns, structNs, f, f.cTags)
 		}
 	}
@@ -81,7 +104,7 @@ func (v *validate) validateStruct(ctx context.Context, parent reflect.Value, cur
 	// check if any struct level validations, after all field validations already checked.
 	// first iteration will have no info about nostructlevel tag, and is checked prior to
 	// calling the next iteration of validateStruct called from traverseField.
-	if cs.fn != nil {
+	if cs.fn != nil && !v.isDone() {
 
 		v.slflParent = parent
 		v.slCurrent = current
@@ -89,14 +112,59 @@ func (v *validate) validateStruct(ctx context.Context, parent reflect.Value, cur
 		v.actualNs = structNs
 
 		cs.fn(ctx, v)
+
+		// struct level funcs may report any number of errors at once
+		if v.maxErrs > 0 && len(v.errs) > v.maxErrs {
+			v.errs = v.errs[:v.maxErrs]
+		}
+	}
+
+	if top {
+		for _, err := range v.errs {
+			if fe, ok := err.(*fieldError); ok {
+				fe.topLen = uint8(len(cs.name) + 1)
+			}
+		}
 	}
 }
 
+// isDone reports whether the maximum number of errors has been collected or
+// the context is done.
+func (v *validate) isDone() bool {
+	return v.ctxErr != nil || (v.maxErrs > 0 && len(v.errs) >= v.maxErrs)
+}
+
+// checkCtx records ctx.Err() every ctxCheckEvery calls, returning whether the
+// context is done.
+func (v *validate) checkCtx(ctx context.Context) bool {
+	if v.ctxErr != nil {
+		return true
+	}
+	if v.ctxCheckEvery == 0 {
+		return false
+	}
+	if v.ctxSteps%v.ctxCheckEvery == 0 {
+		v.ctxErr = ctx.Err()
+	}
+	v.ctxSteps++
+	return v.ctxErr != nil
+}
+
 // traverseField validates any field, be it a struct or single field, ensures it's validity and passes it along to be validated via it's tag options
 func (v *validate) traverseField(ctx context.Context, parent reflect.Value, current reflect.Value, ns []byte, structNs []byte, cf *cField, ct *cTag) {
+	if v.checkCtx(ctx) {
+		return
+	}
+
 	var typ reflect.Type
 	var kind reflect.Kind
 
+	if v.derefIfaces {
+		for current.Kind() == reflect.Interface && !current.IsNil() {
+			current = current.Elem()
+		}
+	}
+
 	current, kind, v.fldIsPointer = v.extractTypeInternal(current, false)
 
 	switch kind {
@@ -127,6 +195,8 @@ func (v *validate) traverseField(ctx context.Context, parent reflect.Value, curr
 						structNs:       v.str2,
 						fieldLen:       uint8(len(cf.altName)),
 						structfieldLen: uint8(len(cf.name)),
+						msgs:           cf.msgs,
+						parent:         structTypeOf(parent),
 						param:          ct.param,
 						kind:           kind,
 					},
@@ -150,6 +220,8 @@ func (v *validate) traverseField(ctx context.Context, parent reflect.Value, curr
 						structNs:       v.str2,
 						fieldLen:       uint8(len(cf.altName)),
 						structfieldLen: uint8(len(cf.name)),
+						msgs:           cf.msgs,
+						parent:         structTypeOf(parent),
 						value:          current.Interface(),
 						param:          ct.param,
 						kind:           kind,
@@ -174,6 +246,7 @@ func (v *validate) traverseField(ctx context.Context, parent reflect.Value, curr
 					// set Field Level fields
 					v.slflParent = parent
 					v.flField = current
+					v.flNs = structNs
 					v.cf = cf
 					v.ct = ct
 
@@ -195,6 +268,8 @@ func (v *validate) traverseField(ctx context.Context, parent reflect.Value, curr
 								structNs:       v.str2,
 								fieldLen:       uint8(len(cf.altName)),
 								structfieldLen: uint8(len(cf.name)),
+								msgs:           cf.msgs,
+								parent:         structTypeOf(parent),
 								value:          current.Interface(),
 								param:          ct.param,
 								kind:           kind,
@@ -269,7 +344,7 @@ OUTER:
 			case reflect.Slice, reflect.Array:
 
 				var i64 int64
-				reusableCF := &cField{}
+				reusableCF := &cField{msgs: cf.msgs}
 
 				for i := 0; i < current.Len(); i++ {
 
@@ -294,12 +369,16 @@ OUTER:
 						reusableCF.altName = string(v.misc)
 					}
 					v.traverseField(ctx, parent, current.Index(i), ns, structNs, reusableCF, ct)
+
+					if v.isDone() {
+						return
+					}
 				}
 
 			case reflect.Map:
 
 				var pv string
-				reusableCF := &cField{}
+				reusableCF := &cField{msgs: cf.msgs}
 
 				for _, key := range current.MapKeys() {
 
@@ -326,12 +405,16 @@ OUTER:
 					if ct != nil && ct.typeof == typeKeys && ct.keys != nil {
 						v.traverseField(ctx, parent, key, ns, structNs, reusableCF, ct.keys)
 						// can be nil when just keys being validated
-						if ct.next != nil {
+						if ct.next != nil && !v.isDone() {
 							v.traverseField(ctx, parent, current.MapIndex(key), ns, structNs, reusableCF, ct.next)
 						}
 					} else {
 						v.traverseField(ctx, parent, current.MapIndex(key), ns, structNs, reusableCF, ct)
 					}
+
+					if v.isDone() {
+						return
+					}
 				}
 
 			default:
@@ -351,6 +434,7 @@ OUTER:
 				// set Field Level fields
 				v.slflParent = parent
 				v.flField = current
+				v.flNs = structNs
 				v.cf = cf
 				v.ct = ct
 
@@ -400,6 +484,8 @@ OUTER:
 								structNs:       v.str2,
 								fieldLen:       uint8(len(cf.altName)),
 								structfieldLen: uint8(len(cf.name)),
+								msgs:           cf.msgs,
+								parent:         structTypeOf(parent),
 								value:          current.Interface(),
 								param:          ct.param,
 								kind:           kind,
@@ -420,6 +506,8 @@ OUTER:
 								structNs:       v.str2,
 								fieldLen:       uint8(len(cf.altName)),
 								structfieldLen: uint8(len(cf.name)),
+								msgs:           cf.msgs,
+								parent:         structTypeOf(parent),
 								value:          current.Interface(),
 								param:          ct.param,
 								kind:           kind,
@@ -439,6 +527,7 @@ OUTER:
 			// set Field Level fields
 			v.slflParent = parent
 			v.flField = current
+			v.flNs = structNs
 			v.cf = cf
 			v.ct = ct
 
@@ -461,6 +550,8 @@ OUTER:
 						structNs:       v.str2,
 						fieldLen:       uint8(len(cf.altName)),
 						structfieldLen: uint8(len(cf.name)),
+						msgs:           cf.msgs,
+						parent:         structTypeOf(parent),
 						value:          current.Interface(),
 						param:          ct.param,
 						kind:           kind,
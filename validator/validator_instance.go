@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	ut "github.com/go-playground/universal-translator"
@@ -14,6 +16,11 @@ import (
 
 const (
 	defaultTagName        = "validate"
+	groupsTag             = "groups"
+	messageTag            = "msg"
+	messageSeparator      = ";"
+	groupRuleSeparator    = ";"
+	groupPrefixSeparator  = ":"
 	utf8HexComma          = "0x2C"
 	utf8Pipe              = "0x7C"
 	tagSeparator          = ","
@@ -38,12 +45,19 @@ const (
 	keysTag               = "keys"
 	endKeysTag            = "endkeys"
 	requiredTag           = "required"
+	expressionTag         = "expr"
+	whenTag               = "when"
+	oneofTag              = "oneof"
+	immutableTag          = "immutable"
+	transitionTag         = "transition"
+	monotonicTag          = "monotonic"
 	namespaceSeparator    = "."
 	leftBracket           = "["
 	rightBracket          = "]"
 	restrictedTagChars    = ".[],|=+()`~!@#$%^&*\\\"/?<>{}"
 	restrictedAliasErr    = "Alias '%s' either contains restricted characters or is the same as a restricted tag needed for normal operation"
 	restrictedTagErr      = "Tag '%s' either contains restricted characters or is the same as a restricted tag needed for normal operation"
+	ctxCheckInterval      = 64
 )
 
 var (
@@ -74,40 +88,30 @@ type internalValidationFuncWrapper struct {
 
 // Validate contains the validator settings and cache
 type Validate struct {
-	tagName          string
-	pool             *sync.Pool
-	hasCustomFuncs   bool
-	hasTagNameFunc   bool
-	tagNameFunc      TagNameFunc
-	structLevelFuncs map[reflect.Type]StructLevelFuncCtx
-	customFuncs      map[reflect.Type]CustomTypeFunc
-	aliases          map[string]string
-	validations      map[string]internalValidationFuncWrapper
-	transTagFunc     map[ut.Translator]map[string]TranslationFunc // map[<locale>]map[<tag>]TranslationFunc
-	tagCache         *tagCache
-	structCache      *structCache
+	tagName        string
+	pool           *sync.Pool
+	hasTagNameFunc bool
+	tagNameFunc    TagNameFunc
+	transTagFunc   map[ut.Translator]map[string]TranslationFunc // map[<locale>]map[<tag>]TranslationFunc
+	reg            atomic.Value                                 // *registry
+	regLock        sync.Mutex                                   // serializes registrations
+	maxErrors      int                                          // 0 means unlimited
+	ctxCheckEvery  int                                          // fields between ctx.Err() checks, 0 disables them
 }
 
 // New returns a new instance of 'validate' with sane defaults.
 func New() *Validate {
 
-	tc := new(tagCache)
-	tc.m.Store(make(map[string]*cTag))
-
-	sc := new(structCache)
-	sc.m.Store(make(map[reflect.Type]*cStruct))
-
 	v := &Validate{
-		tagName:     defaultTagName,
-		aliases:     make(map[string]string, len(bakedInAliases)),
-		validations: make(map[string]internalValidationFuncWrapper, len(bakedInValidators)),
-		tagCache:    tc,
-		structCache: sc,
+		tagName:       defaultTagName,
+		ctxCheckEvery: ctxCheckInterval,
 	}
 
+	r := newRegistry()
+
 	// must copy alias validators for separate validations to be used in each validator instance
 	for k, val := range bakedInAliases {
-		v.RegisterAlias(k, val)
+		r.aliases[k] = val
 	}
 
 	// must copy validators for separate validations to be used in each instance
@@ -116,14 +120,20 @@ func New() *Validate {
 		switch k {
 		// these require that even if the value is nil that the validation should run, omitempty still overrides this behaviour
 		case requiredIfTag, requiredUnlessTag, requiredWithTag, requiredWithAllTag, requiredWithoutTag, requiredWithoutAllTag,
-			excludedWithTag, excludedWithAllTag, excludedWithoutTag, excludedWithoutAllTag:
-			_ = v.registerValidation(k, wrapFunc(val), true, true)
+			excludedWithTag, excludedWithAllTag, excludedWithoutTag, excludedWithoutAllTag, expressionTag, whenTag,
+			immutableTag, transitionTag, monotonicTag:
+			r.validations[k] = internalValidationFuncWrapper{fn: wrapFunc(val), runValidatinOnNil: true}
 		default:
-			// no need to error check here, baked in will always be valid
-			_ = v.registerValidation(k, wrapFunc(val), true, false)
+			r.validations[k] = internalValidationFuncWrapper{fn: wrapFunc(val)}
 		}
 	}
 
+	for k, fn := range bakedInModifiers {
+		r.modifiers[k] = fn
+	}
+
+	v.reg.Store(r)
+
 	v.pool = &sync.Pool{
 		New: func() interface{} {
 			return &validate{
@@ -143,9 +153,54 @@ func (v *Validate) SetTagName(name string) {
 	v.tagName = name
 }
 
+// SetMaxErrors stops validation once n errors have been collected, skipping the
+// remaining fields, dive elements and struct level validations. n <= 0 means
+// no limit, which is the default.
+//
+// NOTE: this method is not thread-safe it is intended that it be called prior
+// to any validation.
+func (v *Validate) SetMaxErrors(n int) {
+	if n < 0 {
+		n = 0
+	}
+	v.maxErrors = n
+}
+
+// SetContextCheckInterval sets how often the context passed to a validation is
+// checked, every n fields and dive elements validated. When the context is
+// canceled or its deadline exceeded the validation stops and returns a
+// *CanceledError. The default is 64; n <= 0 disables the checks, leaving
+// cancellation to the validations using the context.
+//
+// NOTE: this method is not thread-safe it is intended that it be called prior
+// to any validation.
+func (v *Validate) SetContextCheckInterval(n int) {
+	if n < 0 {
+		n = 0
+	}
+	v.ctxCheckEvery = n
+}
+
+// SetFailFast stops validation at the first error when failFast is true, which
+// is cheaper when only whether a value is valid is of interest. It is the same
+// as SetMaxErrors(1), and SetFailFast(false) removes any limit.
+//
+// NOTE: this method is not thread-safe it is intended that it be called prior
+// to any validation.
+func (v *Validate) SetFailFast(failFast bool) {
+	if failFast {
+		v.maxErrors = 1
+	} else {
+		v.maxErrors = 0
+	}
+}
+
 // ValidateMapCtx validates a map using a map of validation rules and allows passing of contextual
 // validation validation information via context.Context.
-func (v Validate) ValidateMapCtx(ctx context.Context, data map[string]interface{}, rules map[string]interface{}) map[string]interface{} {
+//
+// The errors are returned keyed like data; see MapCtx for ValidationErrors with
+// full namespaces and rules for slices of maps.
+func (v *Validate) ValidateMapCtx(ctx context.Context, data map[string]interface{}, rules map[string]interface{}) map[string]interface{} {
 	errs := make(map[string]interface{})
 	for field, rule := range rules {
 		if reflect.ValueOf(rule).Kind() == reflect.Map && reflect.ValueOf(data[field]).Kind() == reflect.Map {
@@ -190,7 +245,7 @@ func (v *Validate) RegisterTagNameFunc(fn TagNameFunc) {
 //
 // NOTES:
 // - if the key already exists, the previous validation function will be replaced.
-// - this method is not thread-safe it is intended that these all be registered prior to any validation
+// - this method is safe to call concurrently with validation; replacing a validation or alias discards all cached tags
 func (v *Validate) RegisterValidation(tag string, fn Func, callValidationEvenIfNull ...bool) error {
 	return v.RegisterValidationCtx(tag, wrapFunc(fn), callValidationEvenIfNull...)
 }
@@ -218,7 +273,13 @@ func (v *Validate) registerValidation(tag string, fn FuncCtx, bakedIn bool, nilC
 	if !bakedIn && (ok || strings.ContainsAny(tag, restrictedTagChars)) {
 		panic(fmt.Sprintf(restrictedTagErr, tag))
 	}
-	v.validations[tag] = internalValidationFuncWrapper{fn: fn, runValidatinOnNil: nilCheckable}
+
+	v.register(func(r *registry) {
+		if r.isRegistered(tag) {
+			r.resetCaches()
+		}
+		r.validations[tag] = internalValidationFuncWrapper{fn: fn, runValidatinOnNil: nilCheckable}
+	})
 	return nil
 }
 
@@ -226,7 +287,7 @@ func (v *Validate) registerValidation(tag string, fn FuncCtx, bakedIn bool, nilC
 // defines a common or complex set of validation(s) to simplify adding validation
 // to structs.
 //
-// NOTE: this function is not thread-safe it is intended that these all be registered prior to any validation
+// NOTE: this method is safe to call concurrently with validation; replacing a validation or alias discards all cached tags
 func (v *Validate) RegisterAlias(alias, tags string) {
 
 	_, ok := restrictedTags[alias]
@@ -235,13 +296,18 @@ func (v *Validate) RegisterAlias(alias, tags string) {
 		panic(fmt.Sprintf(restrictedAliasErr, alias))
 	}
 
-	v.aliases[alias] = tags
+	v.register(func(r *registry) {
+		if r.isRegistered(alias) {
+			r.resetCaches()
+		}
+		r.aliases[alias] = tags
+	})
 }
 
 // RegisterStructValidation registers a StructLevelFunc against a number of types.
 //
 // NOTE:
-// - this method is not thread-safe it is intended that these all be registered prior to any validation
+// - this method is safe to call concurrently with validation, it discards all cached structs
 func (v *Validate) RegisterStructValidation(fn StructLevelFunc, types ...interface{}) {
 	v.RegisterStructValidationCtx(wrapStructLevelFunc(fn), types...)
 }
@@ -250,37 +316,34 @@ func (v *Validate) RegisterStructValidation(fn StructLevelFunc, types ...interfa
 // of contextual validation information via context.Context.
 //
 // NOTE:
-// - this method is not thread-safe it is intended that these all be registered prior to any validation
+// - this method is safe to call concurrently with validation, it discards all cached structs
 func (v *Validate) RegisterStructValidationCtx(fn StructLevelFuncCtx, types ...interface{}) {
 
-	if v.structLevelFuncs == nil {
-		v.structLevelFuncs = make(map[reflect.Type]StructLevelFuncCtx)
-	}
+	v.register(func(r *registry) {
+		// struct level funcs are stored with the cached structs
+		r.resetStructCaches()
 
-	for _, t := range types {
-		tv := reflect.ValueOf(t)
-		if tv.Kind() == reflect.Ptr {
-			t = reflect.Indirect(tv).Interface()
-		}
+		for _, t := range types {
+			tv := reflect.ValueOf(t)
+			if tv.Kind() == reflect.Ptr {
+				t = reflect.Indirect(tv).Interface()
+			}
 
-		v.structLevelFuncs[reflect.TypeOf(t)] = fn
-	}
+			r.structLevelFuncs[reflect.TypeOf(t)] = fn
+		}
+	})
 }
 
 // RegisterCustomTypeFunc registers a CustomTypeFunc against a number of types
 //
-// NOTE: this method is not thread-safe it is intended that these all be registered prior to any validation
+// NOTE: this method is safe to call concurrently with validation
 func (v *Validate) RegisterCustomTypeFunc(fn CustomTypeFunc, types ...interface{}) {
 
-	if v.customFuncs == nil {
-		v.customFuncs = make(map[reflect.Type]CustomTypeFunc)
-	}
-
-	for _, t := range types {
-		v.customFuncs[reflect.TypeOf(t)] = fn
-	}
-
-	v.hasCustomFuncs = true
+	v.register(func(r *registry) {
+		for _, t := range types {
+			r.customFuncs[reflect.TypeOf(t)] = fn
+		}
+	})
 }
 
 // RegisterTranslation registers translations against the provided tag.
@@ -319,6 +382,13 @@ func (v *Validate) Struct(s interface{}) error {
 // It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
 // You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
 func (v *Validate) StructCtx(ctx context.Context, s interface{}) (err error) {
+	return v.structCtx(ctx, s, nil, reflect.Value{})
+}
+
+// structCtx validates s like StructCtx, applying only the rules of the given
+// groups in addition to the rules that are not bound to a group, and giving the
+// validations access to old, the old value of s for StructUpdate, when valid.
+func (v *Validate) structCtx(ctx context.Context, s interface{}, groups []string, old reflect.Value) (err error) {
 
 	val := reflect.ValueOf(s)
 	top := val
@@ -331,24 +401,130 @@ func (v *Validate) StructCtx(ctx context.Context, s interface{}) (err error) {
 		return &InvalidValidationError{Type: reflect.TypeOf(s)}
 	}
 
+	if old.IsValid() && old.Type() != val.Type() {
+		return &InvalidValidationError{Type: old.Type()}
+	}
+
 	// good to validate
+	vd := v.getValidate(top)
+	vd.sc = vd.r.groupStructCache(groups)
+	vd.old = old
+	// vd.hasExcludes = false // only need to reset in StructPartial and StructExcept
+
+	vd.validateStruct(ctx, top, val, val.Type(), vd.ns[0:0], vd.actualNs[0:0], nil)
+
+	return v.putValidate(vd)
+}
+
+// getValidate takes a validate from the pool, set up to validate top with the
+// current registrations.
+func (v *Validate) getValidate(top reflect.Value) *validate {
 	vd := v.pool.Get().(*validate)
+	vd.r = v.registry()
+	vd.sc = vd.r.structCache
+	vd.maxErrs = v.maxErrors
+	vd.ctxCheckEvery = v.ctxCheckEvery
+	vd.ctxSteps = 0
 	vd.top = top
 	vd.isPartial = false
-	// vd.hasExcludes = false // only need to reset in StructPartial and StructExcept
+	return vd
+}
 
-	vd.validateStruct(ctx, top, val, val.Type(), vd.ns[0:0], vd.actualNs[0:0], nil)
+// putValidate returns vd to the pool and the errors of its validation, a
+// CanceledError when its context was done or ValidationErrors.
+func (v *Validate) putValidate(vd *validate) (err error) {
 
-	if len(vd.errs) > 0 {
+	if vd.ctxErr != nil {
+		err = &CanceledError{Err: vd.ctxErr, Errors: vd.errs}
+		vd.errs = nil
+		vd.ctxErr = nil
+	} else if len(vd.errs) > 0 {
 		err = vd.errs
 		vd.errs = nil
 	}
 
+	vd.old = reflect.Value{}
+	vd.derefIfaces = false
 	v.pool.Put(vd)
 
 	return
 }
 
+// StructGroups validates a structs exposed fields like StructCtx, applying only the rules
+// of the given validation groups in addition to the rules that are not bound to a group.
+//
+// Rules are bound to groups either with a groups tag listing the groups a field is
+// validated in, eg. `validate:"required" groups:"create"`, or by prefixing ';'
+// separated sections of the tag with a group, eg. `validate:"create:required;update:omitempty,min=1"`.
+//
+// It returns InvalidValidationError for bad values passed in and nil or ValidationErrors as error otherwise.
+// You will need to assert the error if it's not nil eg. err.(validator.ValidationErrors) to access the array of errors.
+func (v *Validate) StructGroups(ctx context.Context, s interface{}, groups ...string) (err error) {
+	return v.structCtx(ctx, s, groups, reflect.Value{})
+}
+
+// Precompile parses and caches the validation tags of the given structs, and of
+// all structs reachable through their fields, ahead of their first validation.
+// Tags are otherwise parsed when a struct is first validated and panic when
+// invalid, so calling Precompile at startup surfaces a typo in a rarely used
+// struct early. The rules of all validation groups are checked.
+//
+// The arguments may be structs, pointers to structs, even nil ones, or their
+// reflect.Type. It returns PrecompileErrors holding a *FieldTagError for every
+// invalid tag found, or nil.
+func (v *Validate) Precompile(types ...interface{}) error {
+
+	r := v.registry()
+	seen := make(map[reflect.Type]struct{})
+	var errs PrecompileErrors
+
+	for _, t := range types {
+		typ, ok := t.(reflect.Type)
+		if !ok {
+			typ = reflect.TypeOf(t)
+		}
+		if typ != nil {
+			v.precompileType(r, typ, seen, &errs)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// groupStructCache returns the struct cache for the given set of validation groups.
+func (r *registry) groupStructCache(groups []string) *structCache {
+	if len(groups) == 0 {
+		return r.structCache
+	}
+
+	sorted := append([]string(nil), groups...)
+	sort.Strings(sorted)
+	key := strings.Join(sorted, tagSeparator)
+
+	sc, ok := r.groupCache.Get(key)
+	if ok {
+		return sc
+	}
+
+	r.groupCache.lock.Lock()
+	defer r.groupCache.lock.Unlock()
+
+	if sc, ok = r.groupCache.Get(key); ok {
+		return sc
+	}
+
+	sc = &structCache{groups: make(map[string]struct{}, len(groups))}
+	sc.m.Store(make(map[reflect.Type]*cStruct))
+	for _, g := range groups {
+		sc.groups[g] = struct{}{}
+	}
+	r.groupCache.Set(key, sc)
+	return sc
+}
+
 // StructFiltered validates a structs exposed fields, that pass the FilterFunc check and automatically validates
 // nested structs, unless otherwise specified.
 //
@@ -377,22 +553,14 @@ func (v *Validate) StructFilteredCtx(ctx context.Context, s interface{}, fn Filt
 	}
 
 	// good to validate
-	vd := v.pool.Get().(*validate)
-	vd.top = top
+	vd := v.getValidate(top)
 	vd.isPartial = true
 	vd.ffn = fn
 	// vd.hasExcludes = false // only need to reset in StructPartial and StructExcept
 
 	vd.validateStruct(ctx, top, val, val.Type(), vd.ns[0:0], vd.actualNs[0:0], nil)
 
-	if len(vd.errs) > 0 {
-		err = vd.errs
-		vd.errs = nil
-	}
-
-	v.pool.Put(vd)
-
-	return
+	return v.putValidate(vd)
 }
 
 // StructPartial validates the fields passed in only, ignoring all others.
@@ -425,8 +593,7 @@ func (v *Validate) StructPartialCtx(ctx context.Context, s interface{}, fields .
 	}
 
 	// good to validate
-	vd := v.pool.Get().(*validate)
-	vd.top = top
+	vd := v.getValidate(top)
 	vd.isPartial = true
 	vd.ffn = nil
 	vd.hasExcludes = false
@@ -475,14 +642,7 @@ func (v *Validate) StructPartialCtx(ctx context.Context, s interface{}, fields .
 
 	vd.validateStruct(ctx, top, val, typ, vd.ns[0:0], vd.actualNs[0:0], nil)
 
-	if len(vd.errs) > 0 {
-		err = vd.errs
-		vd.errs = nil
-	}
-
-	v.pool.Put(vd)
-
-	return
+	return v.putValidate(vd)
 }
 
 // StructExcept validates all fields except the ones passed in.
@@ -515,8 +675,7 @@ func (v *Validate) StructExceptCtx(ctx context.Context, s interface{}, fields ..
 	}
 
 	// good to validate
-	vd := v.pool.Get().(*validate)
-	vd.top = top
+	vd := v.getValidate(top)
 	vd.isPartial = true
 	vd.ffn = nil
 	vd.hasExcludes = true
@@ -540,14 +699,7 @@ func (v *Validate) StructExceptCtx(ctx context.Context, s interface{}, fields ..
 
 	vd.validateStruct(ctx, top, val, typ, vd.ns[0:0], vd.actualNs[0:0], nil)
 
-	if len(vd.errs) > 0 {
-		err = vd.errs
-		vd.errs = nil
-	}
-
-	v.pool.Put(vd)
-
-	return
+	return v.putValidate(vd)
 }
 
 // Var validates a single variable using tag style validation.
@@ -586,19 +738,12 @@ func (v *Validate) VarCtx(ctx context.Context, field interface{}, tag string) (e
 		return nil
 	}
 
-	ctag := v.fetchCacheTag(tag)
 	val := reflect.ValueOf(field)
-	vd := v.pool.Get().(*validate)
-	vd.top = val
-	vd.isPartial = false
+	vd := v.getValidate(val)
+	ctag := v.fetchCacheTag(vd.r, tag)
 	vd.traverseField(ctx, val, val, vd.ns[0:0], vd.actualNs[0:0], defaultCField, ctag)
 
-	if len(vd.errs) > 0 {
-		err = vd.errs
-		vd.errs = nil
-	}
-	v.pool.Put(vd)
-	return
+	return v.putValidate(vd)
 }
 
 // VarWithValue validates a single variable, against another variable/field's value using tag style validation
@@ -607,6 +752,9 @@ func (v *Validate) VarCtx(ctx context.Context, field interface{}, tag string) (e
 // s2 := "abcd"
 // validate.VarWithValue(s1, s2, "eqcsfield") // returns true
 //
+// The other value is also the old value of the immutable, transition and monotonic tags eg.
+// validate.VarWithValue(newStatus, oldStatus, "transition=draft>published|published>archived")
+//
 // WARNING: a struct can be passed for validation eg. time.Time is a struct or
 // if you have a custom type and have registered a custom type handler, so must
 // allow it; however unforeseen validations will occur if trying to validate a
@@ -638,17 +786,11 @@ func (v *Validate) VarWithValueCtx(ctx context.Context, field interface{}, other
 	if len(tag) == 0 || tag == skipValidationTag {
 		return nil
 	}
-	ctag := v.fetchCacheTag(tag)
 	otherVal := reflect.ValueOf(other)
-	vd := v.pool.Get().(*validate)
-	vd.top = otherVal
-	vd.isPartial = false
+	vd := v.getValidate(otherVal)
+	vd.old = otherVal
+	ctag := v.fetchCacheTag(vd.r, tag)
 	vd.traverseField(ctx, otherVal, reflect.ValueOf(field), vd.ns[0:0], vd.actualNs[0:0], defaultCField, ctag)
 
-	if len(vd.errs) > 0 {
-		err = vd.errs
-		vd.errs = nil
-	}
-	v.pool.Put(vd)
-	return
+	return v.putValidate(vd)
 }
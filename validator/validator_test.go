@@ -7,10 +7,13 @@ import (
 	"database/sql/driver"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"path/filepath"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -2024,6 +2027,7 @@ func TestCrossNamespaceFieldValidation(t *testing.T) {
 	vd := New()
 	v := &validate{
 		v: vd,
+		r: vd.registry(),
 	}
 
 	current, kind, _, ok := v.getStructFieldOKInternal(val, "Inner.CreatedAt")
@@ -3067,7 +3071,7 @@ func TestBadKeyValidation(t *testing.T) {
 		Name: "test",
 	}
 
-	PanicMatches(t, func() { _ = validate.Struct(tst2) }, "Invalid validation tag on field 'Name'")
+	PanicMatches(t, func() { _ = validate.Struct(tst2) }, "Invalid validation tag on field 'Name': empty validation tag at column 10 of \"required,,len=2\"")
 }
 
 func TestInterfaceErrValidation(t *testing.T) {
@@ -7476,7 +7480,7 @@ func TestOrTag(t *testing.T) {
 
 	s = "this is right, but a blank or isn't"
 
-	PanicMatches(t, func() { _ = validate.Var(s, "rgb||len=13") }, "Invalid validation tag on field ''")
+	PanicMatches(t, func() { _ = validate.Var(s, "rgb||len=13") }, "Invalid validation tag on field '': empty validation tag at column 5 of \"rgb||len=13\"")
 	PanicMatches(t, func() { _ = validate.Var(s, "rgb|rgbaa|len=13") }, "Undefined validation function 'rgbaa' on field ''")
 
 	v2 := New()
@@ -8295,13 +8299,13 @@ func TestMutipleRecursiveExtractStructCache(t *testing.T) {
 	name := "Recursive"
 	proceed := make(chan struct{})
 
-	sc := validate.extractStructCache(current, name)
+	sc := validate.extractStructCache(validate.registry(), validate.registry().structCache, current, name)
 	ptr := fmt.Sprintf("%p", sc)
 
 	for i := 0; i < 100; i++ {
 		go func() {
 			<-proceed
-			sc := validate.extractStructCache(current, name)
+			sc := validate.extractStructCache(validate.registry(), validate.registry().structCache, current, name)
 			Equal(t, ptr, fmt.Sprintf("%p", sc))
 		}()
 	}
@@ -11323,3 +11327,1638 @@ func TestPostCodeByIso3166Alpha2Field_InvalidKind(t *testing.T) {
 	_ = New().Struct(test{"ABC", 123, false})
 	t.Errorf("Didn't panic as expected")
 }
+
+func TestStructGroups(t *testing.T) {
+	type Address struct {
+		City string `validate:"create:required"`
+	}
+
+	type User struct {
+		ID      int    `validate:"required" groups:"update"`
+		Name    string `validate:"create:required;update:omitempty,min=3"`
+		Email   string `validate:"required,email"`
+		Note    string `validate:"max=5;create:min=2"`
+		Address Address
+		Skipped Address `groups:"update"`
+	}
+
+	validate := New()
+
+	// default rules only
+	errs := validate.Struct(User{Email: "a@b.co", Note: "x"})
+	Equal(t, errs, nil)
+
+	errs = validate.StructGroups(context.Background(), User{Email: "a@b.co", Note: "x"}, "create")
+	NotEqual(t, errs, nil)
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 3)
+	AssertError(t, errs, "User.Name", "User.Name", "Name", "Name", "required")
+	AssertError(t, errs, "User.Note", "User.Note", "Note", "Note", "min")
+	AssertError(t, errs, "User.Address.City", "User.Address.City", "City", "City", "required")
+
+	errs = validate.StructGroups(context.Background(), &User{Email: "a@b.co", Name: "ab"}, "update")
+	NotEqual(t, errs, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 2)
+	AssertError(t, errs, "User.ID", "User.ID", "ID", "ID", "required")
+	AssertError(t, errs, "User.Name", "User.Name", "Name", "Name", "min")
+
+	errs = validate.StructGroups(context.Background(), User{ID: 1, Email: "a@b.co"}, "update")
+	Equal(t, errs, nil)
+
+	// multiple groups combine their rules
+	errs = validate.StructGroups(context.Background(), User{Email: "a@b.co", Name: "ab"}, "update", "create")
+	NotEqual(t, errs, nil)
+	ve = errs.(ValidationErrors)
+	Equal(t, len(ve), 5)
+	AssertError(t, errs, "User.Skipped.City", "User.Skipped.City", "City", "City", "required")
+
+	// default rules still apply in every group
+	errs = validate.StructGroups(context.Background(), User{ID: 1, Email: "invalid"}, "update")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "User.Email", "User.Email", "Email", "Email", "email")
+
+	// group caches are shared per set of groups
+	Equal(t, validate.registry().groupStructCache([]string{"update", "create"}), validate.registry().groupStructCache([]string{"create", "update"}))
+	Equal(t, validate.registry().groupStructCache(nil), validate.registry().structCache)
+
+	errs = validate.StructGroups(context.Background(), 1, "create")
+	NotEqual(t, errs, nil)
+	Equal(t, errs.Error(), "validator: (nil int)")
+}
+
+func TestGroupRulesParamWithColon(t *testing.T) {
+	type Test struct {
+		Time string `validate:"datetime=15:04"`
+	}
+
+	validate := New()
+
+	errs := validate.StructGroups(context.Background(), Test{Time: "12:30"}, "create")
+	Equal(t, errs, nil)
+
+	errs = validate.Struct(Test{Time: "bad"})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Test.Time", "Test.Time", "Time", "Time", "datetime")
+}
+
+func TestTagGrammarGrouping(t *testing.T) {
+	validate := New()
+
+	errs := validate.Var("a@b.co", "(email|e164),max=64")
+	Equal(t, errs, nil)
+
+	errs = validate.Var("+14155552671", "(email|e164),max=64")
+	Equal(t, errs, nil)
+
+	errs = validate.Var("invalid", "(email|e164),max=64")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "email|e164")
+
+	errs = validate.Var(strings.Repeat("a", 60)+"@b.co", "(email|e164),max=64")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "max")
+
+	// 'and' nested within 'or'
+	tag := "(startswith=a,len=3)|numeric"
+	Equal(t, validate.Var("abc", tag), nil)
+	Equal(t, validate.Var("12345", tag), nil)
+	errs = validate.Var("abcd", tag)
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", tag)
+
+	// aliases can be used within expressions
+	validate.RegisterAlias("short", "min=1,max=3")
+	Equal(t, validate.Var("ab", "(short,alpha)|numeric"), nil)
+	NotEqual(t, validate.Var("abcd", "(short,alpha)|numeric"), nil)
+	Equal(t, validate.Var("green", "(iscolor|alpha)"), nil)
+
+	type Test struct {
+		Field string `validate:"omitempty,(email|e164),max=64"`
+	}
+	Equal(t, validate.Struct(Test{}), nil)
+	errs = validate.Struct(Test{Field: "bad"})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Test.Field", "Test.Field", "Field", "Field", "email|e164")
+}
+
+func TestTagGrammarNegation(t *testing.T) {
+	validate := New()
+
+	errs := validate.Var("john", "!contains=admin")
+	Equal(t, errs, nil)
+
+	errs = validate.Var("superadmin", "required,!contains=admin")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "!contains")
+	Equal(t, errs.(ValidationErrors)[0].Param(), "admin")
+
+	errs = validate.Var("superadmin", "!(contains=admin|contains=root)")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "!(contains=admin|contains=root)")
+
+	Equal(t, validate.Var("abc", "!!alpha"), nil)
+	Equal(t, validate.Var("123", "!alpha|len=1"), nil)
+}
+
+func TestTagGrammarQuotedParams(t *testing.T) {
+	validate := New()
+
+	tag := "oneof='a b' 'c,d' 'e|f'"
+	Equal(t, validate.Var("a b", tag), nil)
+	Equal(t, validate.Var("c,d", tag), nil)
+	Equal(t, validate.Var("e|f", tag), nil)
+	errs := validate.Var("c", tag)
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "oneof")
+	Equal(t, errs.(ValidationErrors)[0].Param(), "'a b' 'c,d' 'e|f'")
+
+	Equal(t, validate.Var("a)", "(oneof='a)' 'b'),len=2"), nil)
+
+	// a ')' outside of any group remains part of the param
+	Equal(t, validate.Var("a)", "endswith=)"), nil)
+
+	// apostrophes within a param, and quotes which are not closed, are literal
+	Equal(t, validate.Var("O'Brien", "startswith=O'Brien"), nil)
+	Equal(t, validate.Var("O'Brien, Jr.", "startswith=O'Brien,contains=Jr"), nil)
+	NotEqual(t, validate.Var("OBrien", "startswith=O'Brien"), nil)
+	Equal(t, validate.Var("it's", "contains='"), nil)
+	NotEqual(t, validate.Var("its", "contains='"), nil)
+	Equal(t, validate.Var("abc", `excludesall='"`), nil)
+	NotEqual(t, validate.Var(`a"c`, `excludesall='"`), nil)
+}
+
+func TestTagGrammarErrors(t *testing.T) {
+	validate := New()
+
+	tests := []struct {
+		tag      string
+		expected string
+	}{
+		{"(email|e164", `Invalid validation tag on field '': missing closing ')' at column 1 of "(email|e164"`},
+		{"email)", `Invalid validation tag on field '': unexpected ')' at column 6 of "email)"`},
+		{"required,", `Invalid validation tag on field '': missing validation tag at column 10 of "required,"`},
+		{"()", `Invalid validation tag on field '': empty validation tag at column 2 of "()"`},
+		{"max(5)", `Invalid validation tag on field '': unexpected '(' at column 4 of "max(5)"`},
+		{"=5", `Invalid validation tag on field '': missing validation tag before '=' at column 1 of "=5"`},
+		{"oneof='a b", `Invalid validation tag on field '': unterminated quote at column 7 of "oneof='a b"`},
+		{"!", `Invalid validation tag on field '': missing validation tag at column 2 of "!"`},
+		{"!dive", `Invalid validation tag on field '': 'dive' cannot be used within a grouped or negated expression at column 2 of "!dive"`},
+		{"(required|zzxx)", "Undefined validation function 'zzxx' on field ''"},
+	}
+
+	for _, tt := range tests {
+		PanicMatches(t, func() { _ = validate.Var("", tt.tag) }, tt.expected)
+	}
+
+	type Test struct {
+		Field string `validate:"required,(alpha|"`
+	}
+
+	defer func() {
+		err, ok := recover().(*TagSyntaxError)
+		Equal(t, ok, true)
+		Equal(t, err.Field, "Field")
+		Equal(t, err.Column, 17)
+	}()
+	_ = validate.Struct(Test{})
+}
+
+func TestExprTag(t *testing.T) {
+	validate := New()
+
+	type Address struct {
+		Country string
+		Zip     string `validate:"expr=Country != 'US' || len(this) == 5"`
+	}
+
+	type Order struct {
+		Type     string
+		Price    float64
+		Discount float64  `validate:"expr=this <= Price * 0.5"`
+		Quantity int      `validate:"expr=this > 0 && this % 2 == 0,max=100"`
+		Currency string   `validate:"expr=this in ('EUR', 'USD')"`
+		Tags     []string `validate:"expr=len(this) <= 2 && !('internal' in this)"`
+		Starts   time.Time
+		Ends     time.Time `validate:"expr=this > Starts"`
+		Address  Address
+		Email    *string `validate:"expr=this != nil || $.Type == 'guest'"`
+	}
+
+	now := time.Now()
+	email := "a@b.c"
+
+	o := Order{
+		Price:    10,
+		Discount: 5,
+		Quantity: 4,
+		Currency: "EUR",
+		Tags:     []string{"a"},
+		Starts:   now,
+		Ends:     now.Add(time.Hour),
+		Address:  Address{Country: "US", Zip: "12345"},
+		Email:    &email,
+	}
+	Equal(t, validate.Struct(o), nil)
+
+	o.Discount = 5.5
+	o.Quantity = 3
+	o.Currency = "GBP"
+	o.Tags = []string{"a", "internal"}
+	o.Ends = now
+	o.Address.Zip = "1234"
+	o.Email = nil
+
+	errs := validate.Struct(o)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 7)
+	AssertError(t, errs, "Order.Discount", "Order.Discount", "Discount", "Discount", "expr")
+	AssertError(t, errs, "Order.Quantity", "Order.Quantity", "Quantity", "Quantity", "expr")
+	AssertError(t, errs, "Order.Currency", "Order.Currency", "Currency", "Currency", "expr")
+	AssertError(t, errs, "Order.Tags", "Order.Tags", "Tags", "Tags", "expr")
+	AssertError(t, errs, "Order.Ends", "Order.Ends", "Ends", "Ends", "expr")
+	AssertError(t, errs, "Order.Address.Zip", "Order.Address.Zip", "Zip", "Zip", "expr")
+	AssertError(t, errs, "Order.Email", "Order.Email", "Email", "Email", "expr")
+
+	fe := errs.(ValidationErrors)[0]
+	Equal(t, fe.Param(), "this <= Price * 0.5")
+
+	o.Address.Country = "DE"
+	o.Type = "guest"
+	errs = validate.Struct(o)
+	Equal(t, len(errs.(ValidationErrors)), 5)
+
+	// mismatched types fail the validation rather than panic
+	type Mismatch struct {
+		Name string `validate:"expr=this == 1"`
+	}
+	errs = validate.Struct(Mismatch{Name: "1"})
+	AssertError(t, errs, "Mismatch.Name", "Mismatch.Name", "Name", "Name", "expr")
+
+	errs = validate.Var(12, "expr=this / 4 == 3")
+	Equal(t, errs, nil)
+
+	errs = validate.Var(12, "expr=this / 0 == 3")
+	NotEqual(t, errs, nil)
+
+	errs = validate.Var("abc", "expr=this + 'd' == 'abcd' && 'bc' in this")
+	Equal(t, errs, nil)
+
+	errs = validate.Var(map[string]int{"a": 1}, "(expr='a' in this)|len=0")
+	Equal(t, errs, nil)
+
+	errs = validate.Var(uint8(3), "expr=-this < -2.5")
+	Equal(t, errs, nil)
+
+	errs = validate.Var(5, "!expr=this > 3")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "", "", "", "", "!expr")
+}
+
+func TestWhenTag(t *testing.T) {
+	validate := New()
+
+	type Customer struct {
+		Type      string
+		Country   string
+		VATNumber string `validate:"when=Type == 'company' && Country != 'CN',omitempty,len=10"`
+		Tags      []string
+		Owner     *string `validate:"when=len(Tags) > 0 || Type in ('company', 'ngo')"`
+	}
+
+	owner := "me"
+
+	c := Customer{Type: "person", Country: "DE"}
+	Equal(t, validate.Struct(c), nil)
+
+	c.Type = "company"
+	errs := validate.Struct(c)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 2)
+	AssertError(t, errs, "Customer.VATNumber", "Customer.VATNumber", "VATNumber", "VATNumber", "when")
+	AssertError(t, errs, "Customer.Owner", "Customer.Owner", "Owner", "Owner", "when")
+
+	c.Country = "CN"
+	c.Owner = &owner
+	Equal(t, validate.Struct(c), nil)
+
+	c.VATNumber = "123"
+	errs = validate.Struct(c)
+	AssertError(t, errs, "Customer.VATNumber", "Customer.VATNumber", "VATNumber", "VATNumber", "len")
+
+	c = Customer{Type: "person", Tags: []string{"vip"}}
+	errs = validate.Struct(c)
+	AssertError(t, errs, "Customer.Owner", "Customer.Owner", "Owner", "Owner", "when")
+}
+
+func TestExprTagErrors(t *testing.T) {
+	validate := New()
+
+	tests := []struct {
+		tag      string
+		expected string
+	}{
+		{"expr=", `Invalid validation tag on field '': invalid 'expr' expression: missing expression at column 6 of "expr="`},
+		{"expr", `Invalid validation tag on field '': invalid 'expr' expression: missing expression at column 5 of "expr"`},
+		{"required,expr=A = 1", `Invalid validation tag on field '': invalid 'expr' expression: unexpected '=', use '==' to compare at column 17 of "required,expr=A = 1"`},
+		{"when=(A > 1", `Invalid validation tag on field '': invalid 'when' expression: missing ')' at column 12 of "when=(A > 1"`},
+		{"expr=A in ('x' 'y')", `Invalid validation tag on field '': invalid 'expr' expression: expected ')', found "y" at column 16 of "expr=A in ('x' 'y')"`},
+		{"expr=len(A", `Invalid validation tag on field '': invalid 'expr' expression: missing ')' at column 11 of "expr=len(A"`},
+		{"expr='abc", `Invalid validation tag on field '': unterminated quote at column 6 of "expr='abc"`},
+		{"(min=1|!expr=A >)", `Invalid validation tag on field '': invalid 'expr' expression: missing operand at column 17 of "(min=1|!expr=A >)"`},
+	}
+
+	for _, tt := range tests {
+		PanicMatches(t, func() { _ = validate.Var("", tt.tag) }, tt.expected)
+	}
+
+	// fields are checked against the struct when it is cached
+	type Address struct {
+		City string
+	}
+
+	type Valid struct {
+		Country string
+		Address *Address
+		Items   []Address
+		Labels  map[string]Address
+		Extra   interface{}
+		Total   int `validate:"expr=Address.City != '' && len(Items[0].City) > 0 && Labels[a].City != '' && Extra.Any == nil && $.Any == nil"`
+	}
+	Equal(t, validate.Struct(Valid{
+		Address: &Address{City: "a"},
+		Items:   []Address{{City: "b"}},
+		Labels:  map[string]Address{"a": {City: "c"}},
+	}), nil)
+
+	type Misspelled struct {
+		Country string
+		Total   int `validate:"required,when=Contry != 'CN'"`
+	}
+	PanicMatches(t, func() { _ = validate.Struct(Misspelled{}) }, `Invalid validation tag on field 'Total': invalid 'when' expression: unknown field 'Contry' at column 15 of "required,when=Contry != 'CN'"`)
+
+	type Nested struct {
+		Address Address
+		City    string `validate:"(min=1|!expr=Address.Town == '')"`
+	}
+	err := validate.Precompile(Nested{})
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), `validator: validator.Nested.City: Invalid validation tag on field 'City': invalid 'expr' expression: unknown field 'Address.Town' at column 14 of "(min=1|!expr=Address.Town == '')"`)
+}
+
+func TestPrecompile(t *testing.T) {
+	validate := New()
+
+	type Item struct {
+		Name string `validate:"required,mni=1"`
+		Code string `validate:"(alpha|numeric"`
+	}
+
+	type Address struct {
+		City string `validate:"required"`
+	}
+
+	type Order struct {
+		ID      string `validate:"required"`
+		Items   []*Item
+		Billing map[string]Address
+		Status  string `validate:"create:required;update:oneof=a b,mxa=1"`
+		Skipped Item   `validate:"-"`
+		private Item
+	}
+
+	err := validate.Precompile(&Order{})
+	NotEqual(t, err, nil)
+
+	errs, ok := err.(PrecompileErrors)
+	Equal(t, ok, true)
+	Equal(t, len(errs), 3)
+
+	fte := errs[0].(*FieldTagError)
+	Equal(t, fte.Type == reflect.TypeOf(Item{}), true)
+	Equal(t, fte.Field, "Name")
+	Equal(t, fte.Tag, "required,mni=1")
+	Equal(t, fte.Err.Error(), "Undefined validation function 'mni' on field 'Name'")
+
+	fte = errs[1].(*FieldTagError)
+	Equal(t, fte.Field, "Code")
+	_, ok = fte.Err.(*TagSyntaxError)
+	Equal(t, ok, true)
+
+	fte = errs[2].(*FieldTagError)
+	Equal(t, fte.Type == reflect.TypeOf(Order{}), true)
+	Equal(t, fte.Field, "Status")
+	Equal(t, err.Error(), errs[0].Error()+"\n"+errs[1].Error()+"\n"+errs[2].Error())
+	Equal(t, errs[0].Error(), "validator: validator.Item.Name: Undefined validation function 'mni' on field 'Name'")
+
+	// structs with valid tags are cached, others are left for the first validation to report
+	_, ok = validate.registry().structCache.Get(reflect.TypeOf(Address{}))
+	Equal(t, ok, true)
+	_, ok = validate.registry().structCache.Get(reflect.TypeOf(Order{}))
+	Equal(t, ok, false)
+
+	type Valid struct {
+		Name    string `validate:"required"`
+		Address *Address
+	}
+
+	Equal(t, validate.Precompile(Valid{}, reflect.TypeOf([]Address{}), nil, 5, (*Valid)(nil)), nil)
+	_, ok = validate.registry().structCache.Get(reflect.TypeOf(Valid{}))
+	Equal(t, ok, true)
+
+	errV := validate.Struct(Valid{Address: &Address{}})
+	NotEqual(t, errV, nil)
+	AssertError(t, errV, "Valid.Name", "Valid.Name", "Name", "Name", "required")
+	AssertError(t, errV, "Valid.Address.City", "Valid.Address.City", "City", "City", "required")
+}
+
+func TestRegistrationInvalidatesCache(t *testing.T) {
+	validate := New()
+
+	type Test struct {
+		Name string `validate:"is_valid,short"`
+	}
+
+	Equal(t, validate.RegisterValidation("is_valid", func(fl FieldLevel) bool { return false }), nil)
+	validate.RegisterAlias("short", "max=3")
+
+	errs := validate.Struct(Test{Name: "abcd"})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Test.Name", "Test.Name", "Name", "Name", "is_valid")
+	NotEqual(t, validate.Var("abcd", "is_valid"), nil)
+
+	// registering a new tag keeps the caches
+	r := validate.registry()
+	Equal(t, validate.RegisterValidation("unused", func(fl FieldLevel) bool { return true }), nil)
+	Equal(t, validate.registry().tagCache == r.tagCache, true)
+	Equal(t, validate.registry().structCache == r.structCache, true)
+
+	// replacing a validation or alias discards them
+	Equal(t, validate.RegisterValidation("is_valid", func(fl FieldLevel) bool { return true }), nil)
+	Equal(t, validate.registry().tagCache == r.tagCache, false)
+	Equal(t, validate.Var("abcd", "is_valid"), nil)
+
+	errs = validate.Struct(Test{Name: "abcd"})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Test.Name", "Test.Name", "Name", "Name", "short")
+
+	validate.RegisterAlias("short", "max=5")
+	Equal(t, validate.Struct(Test{Name: "abcd"}), nil)
+
+	// struct level funcs apply to structs already cached
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		sl.ReportError(sl.Current().Interface().(Test).Name, "Name", "Name", "struct", "")
+	}, Test{})
+
+	errs = validate.Struct(Test{Name: "abcd"})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "Test.Name", "Test.Name", "Name", "Name", "struct")
+}
+
+func TestConcurrentRegistration(t *testing.T) {
+	validate := New()
+
+	type Test struct {
+		Name  string `validate:"required,max=10"`
+		Count int    `validate:"min=1"`
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				NotEqual(t, validate.Struct(Test{}), nil)
+				Equal(t, validate.Struct(Test{Name: "name", Count: 1}), nil)
+				Equal(t, validate.Var("abc", "required,max=10"), nil)
+			}
+		}()
+	}
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				tag := fmt.Sprintf("custom_%d_%d", i, j)
+				Equal(t, validate.RegisterValidation(tag, func(fl FieldLevel) bool { return true }), nil)
+				validate.RegisterAlias(tag+"_alias", tag)
+				validate.RegisterStructValidation(func(sl StructLevel) {}, Test{})
+				validate.RegisterCustomTypeFunc(func(field reflect.Value) interface{} { return nil }, sql.NullString{})
+				Equal(t, validate.Var("abc", tag+"_alias"), nil)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	Equal(t, validate.Var("abc", "custom_7_19_alias"), nil)
+}
+
+func TestFailFast(t *testing.T) {
+	validate := New()
+
+	type Inner struct {
+		A string `validate:"required"`
+		B string `validate:"required"`
+	}
+
+	type Test struct {
+		First  string            `validate:"required"`
+		Inner  Inner             `validate:"required"`
+		Slice  []string          `validate:"dive,required"`
+		Map    map[string]string `validate:"dive,keys,min=2,endkeys,required"`
+		Second string            `validate:"required"`
+	}
+
+	tst := Test{
+		Slice: []string{"", ""},
+		Map:   map[string]string{"a": ""},
+	}
+
+	errs := validate.Struct(tst)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 8)
+
+	validate.SetFailFast(true)
+	errs = validate.Struct(tst)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+	AssertError(t, errs, "Test.First", "Test.First", "First", "First", "required")
+
+	validate.SetMaxErrors(3)
+	errs = validate.Struct(tst)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 3)
+	AssertError(t, errs, "Test.Inner.B", "Test.Inner.B", "B", "B", "required")
+
+	// the limit applies within dive
+	validate.SetMaxErrors(4)
+	errs = validate.Struct(tst)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 4)
+	AssertError(t, errs, "Test.Slice[0]", "Test.Slice[0]", "Slice[0]", "Slice[0]", "required")
+
+	// a failing map key skips its value
+	validate.SetMaxErrors(6)
+	errs = validate.Struct(tst)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 6)
+	AssertError(t, errs, "Test.Map[a]", "Test.Map[a]", "Map[a]", "Map[a]", "min")
+
+	errs = validate.Var([]string{"", "", ""}, "dive,required")
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 3)
+
+	validate.SetFailFast(true)
+	errs = validate.Var([]string{"", "", ""}, "dive,required")
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+
+	validate.SetFailFast(false)
+	errs = validate.Struct(tst)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 8)
+
+	validate.SetMaxErrors(-1)
+	errs = validate.Struct(tst)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 8)
+}
+
+func TestFailFastStructLevel(t *testing.T) {
+	validate := New()
+
+	type Test struct {
+		A string `validate:"required"`
+		B string
+	}
+
+	var calls int
+
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		calls++
+		sl.ReportError(sl.Current().Interface().(Test).B, "B", "B", "b1", "")
+		sl.ReportError(sl.Current().Interface().(Test).B, "B", "B", "b2", "")
+	}, Test{})
+
+	errs := validate.Struct(Test{})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 3)
+	Equal(t, calls, 1)
+
+	// skipped once the limit is reached by the fields
+	validate.SetFailFast(true)
+	errs = validate.Struct(Test{})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+	Equal(t, calls, 1)
+
+	// errors reported beyond the limit are dropped
+	errs = validate.Struct(Test{A: "a"})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+	AssertError(t, errs, "Test.B", "Test.B", "B", "B", "b1")
+	Equal(t, calls, 2)
+
+	validate.SetMaxErrors(2)
+	errs = validate.Struct(Test{})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 2)
+	AssertError(t, errs, "Test.B", "Test.B", "B", "B", "b1")
+	Equal(t, calls, 3)
+}
+
+func TestContextCancellation(t *testing.T) {
+	validate := New()
+
+	type Test struct {
+		Name  string   `validate:"required"`
+		Items []string `validate:"dive,counted"`
+	}
+
+	var calls int
+	ctx, cancel := context.WithCancel(context.Background())
+
+	Equal(t, validate.RegisterValidationCtx("counted", func(ctx context.Context, fl FieldLevel) bool {
+		calls++
+		if calls == 100 {
+			cancel()
+		}
+		return true
+	}), nil)
+
+	tst := Test{Items: make([]string, 1000)}
+
+	errs := validate.StructCtx(ctx, tst)
+	NotEqual(t, errs, nil)
+
+	ce, ok := errs.(*CanceledError)
+	Equal(t, ok, true)
+	Equal(t, errors.Is(errs, context.Canceled), true)
+	Equal(t, ce.Error(), "validator: validation stopped: context canceled")
+	Equal(t, len(ce.Errors), 1)
+	AssertError(t, ce.Errors, "Test.Name", "Test.Name", "Name", "Name", "required")
+	Equal(t, calls > 100 && calls <= 100+ctxCheckInterval, true)
+
+	// canceled before validating
+	calls = 0
+	errs = validate.VarCtx(ctx, tst.Items, "dive,counted")
+	Equal(t, errors.Is(errs, context.Canceled), true)
+	Equal(t, calls, 0)
+
+	deadline, cancel2 := context.WithTimeout(context.Background(), -time.Second)
+	defer cancel2()
+
+	errs = validate.StructCtx(deadline, Test{Name: "name"})
+	Equal(t, errors.Is(errs, context.DeadlineExceeded), true)
+	Equal(t, len(errs.(*CanceledError).Errors), 0)
+
+	// the validation is reusable once canceled
+	calls = 1000
+	Equal(t, validate.Struct(Test{Name: "name", Items: []string{"a"}}), nil)
+
+	validate.SetContextCheckInterval(1)
+	calls = 0
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	errs = validate.StructCtx(ctx, tst)
+	Equal(t, errors.Is(errs, context.Canceled), true)
+	Equal(t, calls, 100)
+
+	validate.SetContextCheckInterval(0)
+	calls = 0
+	errs = validate.StructCtx(ctx, tst)
+	NotEqual(t, errs, nil)
+	_, ok = errs.(ValidationErrors)
+	Equal(t, ok, true)
+	Equal(t, calls, 1000)
+}
+
+func TestRegisterStructRules(t *testing.T) {
+	validate := New()
+
+	type Item struct {
+		Name string
+	}
+
+	type External struct {
+		Email string
+		Items []Item
+		Nick  string `validate:"required"`
+		Code  string `validate:"required"`
+		Other string `validate:"max=1"`
+	}
+
+	errs := validate.Struct(External{Items: []Item{{}}, Other: "ab"})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 3)
+
+	Equal(t, validate.RegisterStructRules(External{}, map[string]string{
+		"Email": "required,email",
+		"Items": "required,dive",
+		"Nick":  "omitempty,min=2",
+		"Other": "-",
+	}), nil)
+	Equal(t, validate.RegisterStructRules(&Item{}, map[string]string{"Name": "required"}), nil)
+
+	errs = validate.Struct(External{Items: []Item{{}}, Nick: "a", Other: "ab"})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 4)
+	AssertError(t, errs, "External.Email", "External.Email", "Email", "Email", "required")
+	AssertError(t, errs, "External.Items[0].Name", "External.Items[0].Name", "Name", "Name", "required")
+	AssertError(t, errs, "External.Nick", "External.Nick", "Nick", "Nick", "min")
+	AssertError(t, errs, "External.Code", "External.Code", "Code", "Code", "required")
+
+	Equal(t, validate.Struct(External{Email: "a@b.co", Items: []Item{{Name: "x"}}, Code: "c", Other: "ab"}), nil)
+
+	// rules are replaced
+	Equal(t, validate.RegisterStructRules(reflect.TypeOf(Item{}), map[string]string{}), nil)
+	Equal(t, validate.Var([]Item{{}}, "dive"), nil)
+
+	err := validate.RegisterStructRules(External{}, map[string]string{"Missing": "required"})
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: validator.External has no exported field Missing")
+
+	err = validate.RegisterStructRules(External{}, map[string]string{"Email": "required,emial"})
+	NotEqual(t, err, nil)
+	fte, ok := err.(*FieldTagError)
+	Equal(t, ok, true)
+	Equal(t, fte.Field, "Email")
+	Equal(t, fte.Tag, "required,emial")
+
+	err = validate.RegisterStructRules("string", map[string]string{})
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: string is not a struct type")
+
+	// failed registrations keep the previous rules
+	errs = validate.Struct(External{Items: []Item{{}}, Code: "c"})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+	AssertError(t, errs, "External.Email", "External.Email", "Email", "Email", "required")
+}
+
+func TestLoadStructRules(t *testing.T) {
+
+	type Item struct {
+		Name string
+	}
+
+	type External struct {
+		Email string `json:"email"`
+		Items []Item
+	}
+
+	yamlRules := `
+validator.External:
+  Email: required,email
+  Items: required,dive
+Item:
+  Name: create:required;update:omitempty,min=2
+`
+	jsonRules := `{"External": {"Email": "omitempty,email"}}`
+
+	validate := New()
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+	})
+	Equal(t, validate.LoadStructRules(strings.NewReader(yamlRules), External{}, &Item{}), nil)
+
+	errs := validate.Struct(External{})
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "External.email", "External.Email", "email", "Email", "required")
+	AssertError(t, errs, "External.Items", "External.Items", "Items", "Items", "required")
+
+	errs = validate.StructGroups(context.Background(), External{Email: "a@b.co", Items: []Item{{}}}, "create")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "External.Items[0].Name", "External.Items[0].Name", "Name", "Name", "required")
+
+	errs = validate.StructGroups(context.Background(), External{Email: "a@b.co", Items: []Item{{Name: "a"}}}, "update")
+	NotEqual(t, errs, nil)
+	AssertError(t, errs, "External.Items[0].Name", "External.Items[0].Name", "Name", "Name", "min")
+
+	Equal(t, validate.Precompile(External{}), nil)
+
+	validate = New()
+	Equal(t, validate.LoadStructRules(strings.NewReader(jsonRules), External{}), nil)
+	Equal(t, validate.Struct(External{}), nil)
+	NotEqual(t, validate.Struct(External{Email: "a"}), nil)
+
+	err := validate.LoadStructRules(strings.NewReader(yamlRules), External{})
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: struct rules for unknown type Item")
+
+	err = validate.LoadStructRules(strings.NewReader("External: [a"), External{})
+	NotEqual(t, err, nil)
+	Equal(t, strings.HasPrefix(err.Error(), "validator: invalid struct rules: "), true)
+
+	// nothing is registered if any rule is invalid
+	err = validate.LoadStructRules(strings.NewReader(`{"External": {"Email": "required"}, "Item": {"Name": "bad_tag"}}`), External{}, Item{})
+	NotEqual(t, err, nil)
+	Equal(t, validate.Struct(External{}), nil)
+}
+
+func TestMap(t *testing.T) {
+	validate := New()
+
+	data := map[string]interface{}{
+		"name": "order",
+		"tags": []interface{}{"a", ""},
+		"customer": map[string]interface{}{
+			"email":   "not an email",
+			"address": map[string]interface{}{},
+		},
+		"items": []map[string]interface{}{
+			{"sku": "A1", "qty": 3},
+			{"sku": "", "qty": 1},
+			{"qty": 0},
+		},
+		"notes": "a string",
+	}
+
+	rules := map[string]interface{}{
+		"name":  "required,min=3",
+		"tags":  "required,dive,required",
+		"phone": "omitempty,e164",
+		"id":    "required",
+		"customer": map[string]interface{}{
+			"email":   "required,email",
+			"address": map[string]interface{}{"city": "required"},
+		},
+		"items": NestedRules{
+			Tag:   "required,min=1",
+			Rules: map[string]interface{}{"sku": "required", "qty": "gt=0"},
+		},
+		"notes":    map[string]interface{}{"text": "required"},
+		"optional": map[string]interface{}{"text": "required"},
+		"skipped":  "-",
+	}
+
+	errs := validate.Map(data, rules)
+	NotEqual(t, errs, nil)
+
+	ve := errs.(ValidationErrors)
+	Equal(t, len(ve), 8)
+
+	AssertError(t, errs, "customer.address.city", "customer.address.city", "city", "city", "required")
+	AssertError(t, errs, "customer.email", "customer.email", "email", "email", "email")
+	AssertError(t, errs, "id", "id", "id", "id", "required")
+	AssertError(t, errs, "items[1].sku", "items[1].sku", "sku", "sku", "required")
+	AssertError(t, errs, "items[2].qty", "items[2].qty", "qty", "qty", "gt")
+	AssertError(t, errs, "items[2].sku", "items[2].sku", "sku", "sku", "required")
+	AssertError(t, errs, "notes", "notes", "notes", "notes", "map")
+	AssertError(t, errs, "tags[1]", "tags[1]", "tags[1]", "tags[1]", "required")
+
+	// errors are ordered by key
+	Equal(t, ve[0].Namespace(), "customer.address.city")
+	Equal(t, ve[7].Namespace(), "tags[1]")
+
+	// nested rules are not applied when the value fails its tag
+	errs = validate.Map(map[string]interface{}{"items": []interface{}{}}, map[string]interface{}{
+		"items": NestedRules{Tag: "min=1", Rules: map[string]interface{}{"sku": "required"}},
+	})
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+	AssertError(t, errs, "items", "items", "items", "items", "min")
+
+	Equal(t, validate.Map(map[string]interface{}{"name": "order"}, map[string]interface{}{"name": "required"}), nil)
+
+	validate.SetFailFast(true)
+	errs = validate.Map(data, rules)
+	NotEqual(t, errs, nil)
+	Equal(t, len(errs.(ValidationErrors)), 1)
+
+	// rules are checked before validating, even those of missing keys
+	err := validate.Map(data, map[string]interface{}{"customer": map[string]interface{}{"email": 1}})
+	NotEqual(t, err, nil)
+	_, ok := err.(ValidationErrors)
+	Equal(t, ok, false)
+	Equal(t, err.Error(), "validator: invalid rule of type int for key 'customer.email'")
+
+	err = validate.Map(data, map[string]interface{}{"missing": NestedRules{Rules: map[string]interface{}{"a": []string{"required"}}}})
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: invalid rule of type []string for key 'missing.a'")
+}
+
+func TestValidationErrorsTree(t *testing.T) {
+	validate := New()
+
+	type Address struct {
+		City string `validate:"required"`
+		Zip  string `validate:"required,len=5"`
+	}
+
+	type User struct {
+		Name      string             `validate:"required"`
+		Addresses []Address          `validate:"required,dive"`
+		Phones    map[string]string  `validate:"dive,e164"`
+		Grid      [][]string         `validate:"dive,dive,required"`
+		Primary   *Address           `validate:"required"`
+		Billing   map[string]Address `validate:"dive"`
+	}
+
+	u := User{
+		Addresses: []Address{{City: "a", Zip: "12345"}, {}},
+		Phones:    map[string]string{"home": "123"},
+		Grid:      [][]string{{"a", ""}},
+		Billing:   map[string]Address{"main": {City: "b", Zip: "1"}},
+	}
+
+	errs := validate.Struct(u).(ValidationErrors)
+	Equal(t, len(errs), 7)
+
+	tree := errs.Tree()
+	Equal(t, len(tree.Errors), 0)
+	Equal(t, len(tree.Children), 6)
+	Equal(t, tree.Children["Name"].Errors[0].Tag(), "required")
+	Equal(t, len(tree.Children["Addresses"].Errors), 0)
+	Equal(t, len(tree.Children["Addresses"].Children), 1)
+	Equal(t, len(tree.Children["Addresses"].Children["1"].Children), 2)
+	Equal(t, tree.Children["Addresses"].Children["1"].Children["City"].Errors[0].Namespace(), "User.Addresses[1].City")
+	Equal(t, tree.Children["Phones"].Children["home"].Errors[0].Tag(), "e164")
+	Equal(t, tree.Children["Grid"].Children["0"].Children["1"].Errors[0].Tag(), "required")
+	Equal(t, tree.Children["Primary"].Errors[0].Tag(), "required")
+	Equal(t, tree.Children["Billing"].Children["main"].Children["Zip"].Errors[0].Tag(), "len")
+
+	addr := errs.For("Addresses[1]")
+	Equal(t, len(addr), 2)
+	AssertError(t, addr, "User.Addresses[1].City", "User.Addresses[1].City", "City", "City", "required")
+	AssertError(t, addr, "User.Addresses[1].Zip", "User.Addresses[1].Zip", "Zip", "Zip", "required")
+
+	Equal(t, len(errs.For("Addresses")), 2)
+	Equal(t, len(errs.For("Addresses[0]")), 0)
+	Equal(t, len(errs.For("Address")), 0)
+	Equal(t, len(errs.For("Billing[main].Zip")), 1)
+	Equal(t, len(errs.For("")), 7)
+
+	b, err := json.Marshal(errs.For("Billing").Tree())
+	Equal(t, err, nil)
+	Equal(t, string(b), `{"children":{"Billing":{"children":{"main":{"children":{"Zip":{"errors":[{"tag":"len","param":"5","message":"Key: 'User.Billing[main].Zip' Error:Field validation for 'Zip' failed on the 'len' tag"}]}}}}}}}`)
+
+	// errors of Var and Map have no top level struct
+	errs = validate.Var([]string{"a", ""}, "dive,required").(ValidationErrors)
+	Equal(t, errs.Tree().Children["1"].Errors[0].Tag(), "required")
+
+	errs = validate.Map(map[string]interface{}{}, map[string]interface{}{"user": NestedRules{Tag: "required"}}).(ValidationErrors)
+	Equal(t, errs.Tree().Children["user"].Errors[0].Tag(), "required")
+	Equal(t, len(errs.For("user")), 1)
+
+	// struct level errors and tag name funcs
+	validate.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return strings.ToLower(fld.Name)
+	})
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		sl.ReportError(nil, "zip", "Zip", "custom", "")
+	}, Address{})
+
+	errs = validate.Struct(User{Addresses: []Address{{City: "a", Zip: "12345"}}}).(ValidationErrors)
+	Equal(t, errs.Tree().Children["addresses"].Children["0"].Children["zip"].Errors[0].Tag(), "custom")
+	Equal(t, len(errs.For("addresses[0]")), 1)
+}
+
+func TestMessageTag(t *testing.T) {
+	en := en.New()
+	uni := ut.New(en, en, fr.New())
+	trans, _ := uni.GetTranslator("en")
+	frTrans, _ := uni.GetTranslator("fr")
+
+	Equal(t, frTrans.Add("work_email", "Veuillez saisir votre adresse professionnelle ({0})", false), nil)
+
+	validate := New()
+	validate.RegisterAlias("short", "max=3")
+	Equal(t, validate.RegisterTranslation("required", trans,
+		func(ut ut.Translator) error {
+			return ut.Add("required", "{0} is a required field", false)
+		}, func(ut ut.Translator, fe FieldError) string {
+			t, _ := ut.T(fe.Tag(), fe.Field())
+			return t
+		}), nil)
+
+	type Test struct {
+		Email string   `validate:"required,email" msg:"required=work_email;email=Invalid address {value}"`
+		Name  string   `validate:"required,min=2" msg:"{field} needs {param} or more characters"`
+		Code  string   `validate:"short" msg:"short=At most 3 = three characters"`
+		Tags  []string `validate:"dive,max=2" msg:"max=Tag {field} is too long"`
+		Other string   `validate:"required"`
+	}
+
+	errs := validate.Struct(Test{Name: "a", Code: "abcd", Tags: []string{"abc"}}).(ValidationErrors)
+	Equal(t, len(errs), 5)
+
+	Equal(t, errs[0].Translate(trans), "work_email")
+	Equal(t, errs[0].Translate(frTrans), "Veuillez saisir votre adresse professionnelle (Email)")
+	Equal(t, errs[1].Translate(trans), "Name needs 2 or more characters")
+	Equal(t, errs[2].Translate(trans), "At most 3 = three characters")
+	Equal(t, errs[3].Translate(trans), "Tag Tags[0] is too long")
+	Equal(t, errs[4].Translate(trans), "Other is a required field")
+
+	// without a translator
+	Equal(t, errs[1].Translate(nil), "Name needs 2 or more characters")
+
+	errs = validate.Struct(Test{Email: "nope", Name: "ab", Other: "x"}).(ValidationErrors)
+	Equal(t, len(errs), 1)
+	Equal(t, errs[0].Translate(trans), "Invalid address nope")
+	Equal(t, errs.Translate(trans)["Test.Email"], "Invalid address nope")
+
+	// Error is unchanged
+	Equal(t, errs[0].Error(), "Key: 'Test.Email' Error:Field validation for 'Email' failed on the 'email' tag")
+
+	Equal(t, parseMessages(""), map[string]string(nil))
+	Equal(t, parseMessages(" required = Needed ; ;Bad input"), map[string]string{"required": "Needed", "": "Bad input"})
+}
+
+func TestFieldLabels(t *testing.T) {
+	en := en.New()
+	uni := ut.New(en, en, fr.New())
+	trans, _ := uni.GetTranslator("en")
+	frTrans, _ := uni.GetTranslator("fr")
+
+	validate := New()
+
+	for _, tr := range []ut.Translator{trans, frTrans} {
+		Equal(t, validate.RegisterTranslation("required", tr,
+			func(ut ut.Translator) error {
+				return ut.Add("required", "{0} is required", false)
+			}, func(ut ut.Translator, fe FieldError) string {
+				t, _ := ut.T(fe.Tag(), fe.Field())
+				return t
+			}), nil)
+		Equal(t, validate.RegisterTranslation("eqfield", tr,
+			func(ut ut.Translator) error {
+				return ut.Add("eqfield", "{0} must equal {1}", false)
+			}, func(ut ut.Translator, fe FieldError) string {
+				t, _ := ut.T(fe.Tag(), fe.Field(), fe.Param())
+				return t
+			}), nil)
+	}
+
+	type Test struct {
+		Email    string   `validate:"required" label:"E-mail address"`
+		Password string   `validate:"required" label:"Password"`
+		Confirm  string   `validate:"eqfield=Password" label:"Confirmation"`
+		Tags     []string `validate:"dive,required" label:"Tag"`
+		Name     string   `validate:"required" msg:"Please enter {field}" label:"Full name"`
+		Other    string   `validate:"required"`
+	}
+
+	Equal(t, validate.RegisterFieldLabels(frTrans, &Test{}, map[string]string{
+		"Email":    "Adresse e-mail",
+		"Password": "Mot de passe",
+		"Name":     "Nom",
+	}), nil)
+
+	errs := validate.Struct(Test{Confirm: "x", Tags: []string{""}}).(ValidationErrors)
+	Equal(t, len(errs), 6)
+
+	Equal(t, errs[0].Translate(trans), "E-mail address is required")
+	Equal(t, errs[0].Translate(frTrans), "Adresse e-mail is required")
+	Equal(t, errs[1].Translate(frTrans), "Mot de passe is required")
+	Equal(t, errs[2].Translate(trans), "Confirmation must equal Password")
+	Equal(t, errs[2].Translate(frTrans), "Confirmation must equal Mot de passe")
+	Equal(t, errs[3].Translate(trans), "Tag[0] is required")
+	Equal(t, errs[4].Translate(trans), "Please enter Full name")
+	Equal(t, errs[4].Translate(frTrans), "Please enter Nom")
+	Equal(t, errs[5].Translate(frTrans), "Other is required")
+
+	// the errors themselves are unchanged
+	Equal(t, errs[0].Field(), "Email")
+	Equal(t, errs[2].Param(), "Password")
+
+	// struct level errors
+	validate.RegisterStructValidation(func(sl StructLevel) {
+		sl.ReportError(nil, "Email", "Email", "required", "")
+	}, Test{})
+	errs = validate.Struct(Test{Email: "a", Password: "b", Confirm: "b", Name: "c", Other: "d"}).(ValidationErrors)
+	Equal(t, errs[0].Translate(frTrans), "Adresse e-mail is required")
+
+	// labels are registered safely while errors are translated
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				Equal(t, validate.RegisterFieldLabels(frTrans, Test{}, map[string]string{
+					"Other": fmt.Sprintf("Autre %d", i),
+				}), nil)
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				Equal(t, errs[0].Translate(frTrans), "Adresse e-mail is required")
+			}
+		}()
+	}
+	wg.Wait()
+
+	errs = validate.Struct(Test{Email: "a", Password: "b", Confirm: "b", Name: "c"}).(ValidationErrors)
+	NotEqual(t, errs[len(errs)-1].Translate(frTrans), "Other is required")
+
+	NotEqual(t, validate.RegisterFieldLabels(trans, "string", nil), nil)
+}
+
+func TestModify(t *testing.T) {
+
+	type Address struct {
+		City string `mod:"trim,title"`
+	}
+
+	type Test struct {
+		Email     string            `mod:"trim,lower" validate:"email"`
+		Name      *string           `mod:"collapse"`
+		Bio       string            `mod:"strip_html,truncate=5"`
+		Key       string            `mod:"snake"`
+		Field     string            `mod:"camel"`
+		Tags      []string          `mod:"dive,trim,upper"`
+		Labels    map[string]string `mod:"dive,ltrim"`
+		Value     interface{}       `mod:"rtrim"`
+		Address   Address
+		Addresses []*Address `mod:"dive"`
+		Skipped   string     `mod:"-"`
+		Nil       *string    `mod:"trim"`
+		Number    int        `mod:"trim"`
+	}
+
+	name := "  Jane   Q  Doe "
+	test := Test{
+		Email:     "  Jane@Example.COM ",
+		Name:      &name,
+		Bio:       "<b>Hello</b> world",
+		Key:       "HTTPServer userID",
+		Field:     "user_id",
+		Tags:      []string{" a ", "b "},
+		Labels:    map[string]string{"x": "  y "},
+		Value:     "v  ",
+		Address:   Address{City: " new york"},
+		Addresses: []*Address{{City: "paris "}, nil},
+		Skipped:   " s ",
+		Number:    3,
+	}
+
+	validate := New()
+	Equal(t, validate.Modify(&test), nil)
+	Equal(t, validate.Struct(test), nil)
+
+	Equal(t, test.Email, "jane@example.com")
+	Equal(t, *test.Name, "Jane Q Doe")
+	Equal(t, test.Bio, "Hello")
+	Equal(t, test.Key, "http_server_user_id")
+	Equal(t, test.Field, "userId")
+	Equal(t, test.Tags, []string{"A", "B"})
+	Equal(t, test.Labels, map[string]string{"x": "y "})
+	Equal(t, test.Value, "v")
+	Equal(t, test.Address.City, "New York")
+	Equal(t, test.Addresses[0].City, "Paris")
+	Equal(t, test.Skipped, " s ")
+	Equal(t, test.Number, 3)
+
+	err := validate.Modify(test)
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: (nil validator.Test)")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	Equal(t, validate.ModifyCtx(ctx, &test), context.Canceled)
+
+	type Bad struct {
+		Name string `mod:"nope"`
+	}
+	PanicMatches(t, func() { _ = validate.Modify(&Bad{}) }, "Undefined modifier 'nope' on field 'Name'")
+}
+
+func TestRegisterModifier(t *testing.T) {
+
+	type Test struct {
+		Phones []string `mod:"dive,digits"`
+	}
+
+	validate := New()
+	Equal(t, validate.RegisterModifier("digits", func(ctx context.Context, field reflect.Value, param string) error {
+		if strings.Contains(field.String(), "!") {
+			return errors.New("bad phone")
+		}
+		field.SetString(strings.Map(func(r rune) rune {
+			if r < '0' || r > '9' {
+				return -1
+			}
+			return r
+		}, field.String()))
+		return nil
+	}), nil)
+
+	test := Test{Phones: []string{"+1 (555) 010-9999"}}
+	Equal(t, validate.Modify(&test), nil)
+	Equal(t, test.Phones[0], "15550109999")
+
+	test = Test{Phones: []string{"1", "2!"}}
+	err := validate.Modify(&test)
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: modifier 'digits' failed on 'Test.Phones[1]': bad phone")
+
+	var me *ModifyError
+	Equal(t, errors.As(err, &me), true)
+	Equal(t, me.Namespace, "Test.Phones[1]")
+	Equal(t, me.Tag, "digits")
+
+	type Truncate struct {
+		Code string `mod:"truncate=x"`
+	}
+	err = validate.Modify(&Truncate{})
+	Equal(t, err.Error(), "validator: modifier 'truncate' failed on 'Truncate.Code': invalid length 'x'")
+
+	// replacing a modifier discards the cached tags using it
+	Equal(t, validate.RegisterModifier("digits", func(ctx context.Context, field reflect.Value, param string) error {
+		field.SetString("0")
+		return nil
+	}), nil)
+	test = Test{Phones: []string{"1"}}
+	Equal(t, validate.Modify(&test), nil)
+	Equal(t, test.Phones[0], "0")
+
+	NotEqual(t, validate.RegisterModifier("", nil), nil)
+	NotEqual(t, validate.RegisterModifier("x", nil), nil)
+	PanicMatches(t, func() { _ = validate.RegisterModifier("dive", truncate) }, "Tag 'dive' either contains restricted characters or is the same as a restricted tag needed for normal operation")
+}
+
+type defaultsTLS struct {
+	Enabled bool   `default:"true"`
+	Cert    string `default:"cert.pem"`
+}
+
+type defaultsConfig struct {
+	Name     string        `default:"app"`
+	Port     int           `default:"8080"`
+	Mode     uint8         `default:"02"`
+	Workers  int           `default:"010"`
+	Ratio    float32       `default:"0.5"`
+	Debug    bool          `default:"true"`
+	Timeout  time.Duration `default:"30s"`
+	Since    time.Time     `default:"2021-06-01"`
+	Hosts    []string      `default:"a|b"`
+	Ports    [3]int        `default:"1|2"`
+	IP       net.IP        `default:"127.0.0.1"`
+	Retries  *int          `default:"3"`
+	Levels   []*int        `default:"1|2"`
+	TLS      *defaultsTLS  `default:"{}"`
+	Backup   *defaultsTLS
+	Children []defaultsTLS
+	URL      string
+}
+
+func (c *defaultsConfig) SetDefaults() {
+	if c.URL == "" {
+		c.URL = fmt.Sprintf("http://%s:%d", c.Name, c.Port)
+	}
+}
+
+type defaultsNode struct {
+	Name  string        `default:"node"`
+	Next  *defaultsNode `default:"{}"`
+	Child *defaultsLeaf `default:"{}"`
+}
+
+type defaultsLeaf struct {
+	Name   string        `default:"leaf"`
+	Parent *defaultsNode `default:"{}"`
+}
+
+func TestSetDefaults(t *testing.T) {
+
+	validate := New()
+
+	var cfg defaultsConfig
+	Equal(t, validate.SetDefaults(&cfg), nil)
+
+	Equal(t, cfg.Name, "app")
+	Equal(t, cfg.Port, 8080)
+	Equal(t, cfg.Mode, uint8(2))
+	Equal(t, cfg.Workers, 10)
+	Equal(t, cfg.Ratio, float32(0.5))
+	Equal(t, cfg.Debug, true)
+	Equal(t, cfg.Timeout, 30*time.Second)
+	Equal(t, cfg.Since, time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC))
+	Equal(t, cfg.Hosts, []string{"a", "b"})
+	Equal(t, cfg.Ports, [3]int{1, 2, 0})
+	Equal(t, cfg.IP.String(), "127.0.0.1")
+	Equal(t, *cfg.Retries, 3)
+	Equal(t, len(cfg.Levels), 2)
+	Equal(t, *cfg.Levels[1], 2)
+	Equal(t, *cfg.TLS, defaultsTLS{Enabled: true, Cert: "cert.pem"})
+	Equal(t, cfg.Backup, nil)
+	Equal(t, cfg.URL, "http://app:8080")
+
+	// set fields, and nested structs, are kept
+	zero := 0
+	cfg = defaultsConfig{
+		Name:     "api",
+		Retries:  &zero,
+		Backup:   &defaultsTLS{Cert: "backup.pem"},
+		Children: []defaultsTLS{{Enabled: true}},
+	}
+	Equal(t, validate.SetDefaults(&cfg), nil)
+	Equal(t, cfg.Name, "api")
+	Equal(t, *cfg.Retries, 0)
+	Equal(t, *cfg.Backup, defaultsTLS{Enabled: true, Cert: "backup.pem"})
+	Equal(t, cfg.Children[0], defaultsTLS{Enabled: true, Cert: "cert.pem"})
+	Equal(t, cfg.URL, "http://api:8080")
+
+	// defaults are not shared
+	var a, b defaultsConfig
+	Equal(t, validate.SetDefaults(&a), nil)
+	Equal(t, validate.SetDefaults(&b), nil)
+	a.Hosts[0] = "x"
+	Equal(t, b.Hosts[0], "a")
+
+	// slices of structs
+	cfgs := []defaultsConfig{{}, {Port: 1}}
+	Equal(t, validate.SetDefaults(&cfgs), nil)
+	Equal(t, cfgs[0].Port, 8080)
+	Equal(t, cfgs[1].Port, 1)
+
+	// recursive structs are not allocated again
+	var node defaultsNode
+	Equal(t, validate.SetDefaults(&node), nil)
+	Equal(t, node.Name, "node")
+	Equal(t, node.Next, nil)
+	Equal(t, *node.Child, defaultsLeaf{Name: "leaf"})
+
+	node = defaultsNode{Next: &defaultsNode{}}
+	Equal(t, validate.SetDefaults(&node), nil)
+	Equal(t, node.Next.Name, "node")
+	Equal(t, node.Next.Next, nil)
+	Equal(t, node.Next.Child.Name, "leaf")
+
+	err := validate.SetDefaults(cfg)
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: (nil validator.defaultsConfig)")
+
+	type Bad struct {
+		Port int `default:"http"`
+	}
+	PanicMatches(t, func() { _ = validate.SetDefaults(&Bad{}) }, "validator: invalid default 'http' on field 'Port': strconv.ParseInt: parsing \"http\": invalid syntax")
+
+	// numbers are decimal, like the defaults of binding's form tags
+	type Hex struct {
+		Mode uint `default:"0x10"`
+	}
+	PanicMatches(t, func() { _ = validate.SetDefaults(&Hex{}) }, "validator: invalid default '0x10' on field 'Mode': strconv.ParseUint: parsing \"0x10\": invalid syntax")
+
+	type BadMap struct {
+		Labels map[string]string `default:"a"`
+	}
+	PanicMatches(t, func() { _ = validate.SetDefaults(&BadMap{}) }, "validator: invalid default 'a' on field 'Labels': unsupported type map[string]string")
+}
+
+type updateItem struct {
+	SKU string `validate:"immutable"`
+}
+
+type updatePost struct {
+	ID        int          `validate:"immutable"`
+	CreatedBy *string      `validate:"immutable"`
+	Status    string       `validate:"required,transition=draft>published|published>archived|*>deleted"`
+	Revision  uint         `validate:"monotonic"`
+	UpdatedAt time.Time    `validate:"monotonic"`
+	Tags      []string     `validate:"monotonic"`
+	Items     []updateItem `validate:"dive"`
+}
+
+func TestStructUpdate(t *testing.T) {
+
+	validate := New()
+	ctx := context.Background()
+
+	alice, bob := "alice", "bob"
+	now := time.Now()
+
+	old := updatePost{
+		ID:        1,
+		CreatedBy: &alice,
+		Status:    "draft",
+		Revision:  2,
+		UpdatedAt: now,
+		Tags:      []string{"go"},
+		Items:     []updateItem{{SKU: "a"}},
+	}
+
+	post := old
+	post.CreatedBy = &alice
+	post.Status = "published"
+	post.Revision = 3
+	post.UpdatedAt = now.Add(time.Minute)
+	post.Tags = []string{"go", "validation"}
+	post.Items = []updateItem{{SKU: "a"}, {SKU: "b"}}
+	Equal(t, validate.StructUpdate(ctx, old, &post), nil)
+
+	// unchanged values pass
+	Equal(t, validate.StructUpdate(ctx, &old, old), nil)
+
+	// the rules pass when there is no old value
+	Equal(t, validate.Struct(post), nil)
+
+	post = old
+	post.ID = 2
+	post.CreatedBy = &bob
+	post.Status = "archived"
+	post.Revision = 1
+	post.UpdatedAt = now.Add(-time.Minute)
+	post.Tags = nil
+	post.Items = []updateItem{{SKU: "b"}}
+
+	err := validate.StructUpdate(ctx, old, post)
+	NotEqual(t, err, nil)
+
+	errs := err.(ValidationErrors)
+	Equal(t, len(errs), 7)
+	AssertError(t, errs, "updatePost.ID", "updatePost.ID", "ID", "ID", "immutable")
+	AssertError(t, errs, "updatePost.CreatedBy", "updatePost.CreatedBy", "CreatedBy", "CreatedBy", "immutable")
+	AssertError(t, errs, "updatePost.Status", "updatePost.Status", "Status", "Status", "transition")
+	AssertError(t, errs, "updatePost.Revision", "updatePost.Revision", "Revision", "Revision", "monotonic")
+	AssertError(t, errs, "updatePost.UpdatedAt", "updatePost.UpdatedAt", "UpdatedAt", "UpdatedAt", "monotonic")
+	AssertError(t, errs, "updatePost.Tags", "updatePost.Tags", "Tags", "Tags", "monotonic")
+	AssertError(t, errs, "updatePost.Items[0].SKU", "updatePost.Items[0].SKU", "SKU", "SKU", "immutable")
+
+	// nil pointers are compared as values
+	post = old
+	post.CreatedBy = nil
+	err = validate.StructUpdate(ctx, old, post)
+	NotEqual(t, err, nil)
+	AssertError(t, err, "updatePost.CreatedBy", "updatePost.CreatedBy", "CreatedBy", "CreatedBy", "immutable")
+
+	old.CreatedBy = nil
+	Equal(t, validate.StructUpdate(ctx, old, post), nil)
+
+	// wildcards
+	post = old
+	post.Status = "deleted"
+	Equal(t, validate.StructUpdate(ctx, old, post), nil)
+
+	// other values
+	Equal(t, validate.VarWithValue("published", "draft", "transition=draft>published|published>archived"), nil)
+	NotEqual(t, validate.VarWithValue("draft", "published", "transition=draft>published|published>archived"), nil)
+	Equal(t, validate.VarWithValue(3, 2, "monotonic"), nil)
+	NotEqual(t, validate.VarWithValue(1, 2, "monotonic"), nil)
+
+	err = validate.VarWithValue([]int{2, 3}, []int{2, 5}, "dive,monotonic")
+	NotEqual(t, err, nil)
+	AssertError(t, err, "[1]", "[1]", "[1]", "[1]", "monotonic")
+
+	// the other value is not kept
+	Equal(t, validate.Var(1, "monotonic"), nil)
+
+	var invalid *InvalidValidationError
+
+	err = validate.StructUpdate(ctx, old, 1)
+	NotEqual(t, err, nil)
+	Equal(t, errors.As(err, &invalid), true)
+
+	err = validate.StructUpdate(ctx, updateItem{}, post)
+	NotEqual(t, err, nil)
+	Equal(t, errors.As(err, &invalid), true)
+
+	PanicMatches(t, func() { _ = validate.VarWithValue("a", "b", "transition=a>b|b") }, `Invalid validation tag on field '': invalid 'transition' param: missing '>' in transition 'b' at column 16 of "transition=a>b|b"`)
+	PanicMatches(t, func() { _ = validate.Var("a", "transition") }, `Invalid validation tag on field '': invalid 'transition' param: missing transitions at column 12 of "transition"`)
+	PanicMatches(t, func() { _ = validate.VarWithValue("a", "b", "monotonic") }, "Bad field type string")
+
+	// bad rules are reported by Precompile rather than by the first update
+	type Bad struct {
+		Status  string            `validate:"required,transition=draft-published"`
+		Name    string            `validate:"monotonic"`
+		Labels  map[string]string `validate:"dive,keys,monotonic,endkeys"`
+		Flags   []bool            `validate:"omitempty,dive,monotonic"`
+		Counts  map[string]int    `validate:"monotonic,dive,keys,min=1,endkeys,monotonic"`
+		Created *time.Time        `validate:"monotonic"`
+	}
+
+	err = validate.Precompile(Bad{})
+	NotEqual(t, err, nil)
+
+	perrs := err.(PrecompileErrors)
+	Equal(t, len(perrs), 4)
+	Equal(t, perrs[0].(*FieldTagError).Err.Error(), `Invalid validation tag on field 'Status': invalid 'transition' param: missing '>' in transition 'draft-published' at column 21 of "required,transition=draft-published"`)
+	Equal(t, perrs[1].(*FieldTagError).Err.Error(), `Invalid validation tag on field 'Name': 'monotonic' cannot be used on values of type string at column 1 of "monotonic"`)
+	Equal(t, perrs[2].(*FieldTagError).Err.Error(), `Invalid validation tag on field 'Labels': 'monotonic' cannot be used on values of type string at column 11 of "dive,keys,monotonic,endkeys"`)
+	Equal(t, perrs[3].(*FieldTagError).Err.Error(), `Invalid validation tag on field 'Flags': 'monotonic' cannot be used on values of type bool at column 16 of "omitempty,dive,monotonic"`)
+
+	PanicMatches(t, func() { _ = validate.StructUpdate(ctx, Bad{}, Bad{}) }, `Invalid validation tag on field 'Status': invalid 'transition' param: missing '>' in transition 'draft-published' at column 21 of "required,transition=draft-published"`)
+}
+
+type variantPayment interface {
+	Amount() int
+}
+
+type variantCard struct {
+	Number string `json:"number" validate:"required,len=16"`
+	Cents  int    `json:"cents" validate:"gt=0"`
+}
+
+func (c variantCard) Amount() int { return c.Cents }
+
+type variantBank struct {
+	IBAN  string `json:"iban" validate:"required"`
+	Cents int    `json:"cents" validate:"gt=0"`
+}
+
+func (b *variantBank) Amount() int { return b.Cents }
+
+type variantOrder struct {
+	Type    string      `validate:"oneof=card bank cash"`
+	Payment interface{} `validate:"required"`
+}
+
+type variantInvoice struct {
+	Kind    int
+	Payment variantPayment
+}
+
+func TestRegisterVariants(t *testing.T) {
+
+	validate := New()
+
+	err := validate.RegisterVariants(variantOrder{}, "Payment", "Type", map[string]interface{}{
+		"card": variantCard{},
+		"bank": reflect.TypeOf(variantBank{}),
+	})
+	Equal(t, err, nil)
+
+	Equal(t, validate.Struct(variantOrder{Type: "card", Payment: variantCard{Number: "4111111111111111", Cents: 100}}), nil)
+	Equal(t, validate.Struct(variantOrder{Type: "bank", Payment: &variantBank{IBAN: "DE89370400440532013000", Cents: 100}}), nil)
+
+	// the rules of the variant are reported under the field
+	err = validate.Struct(&variantOrder{Type: "card", Payment: &variantCard{Number: "4111"}})
+	NotEqual(t, err, nil)
+	Equal(t, len(err.(ValidationErrors)), 2)
+	AssertError(t, err, "variantOrder.Payment.Number", "variantOrder.Payment.Number", "Number", "Number", "len")
+	AssertError(t, err, "variantOrder.Payment.Cents", "variantOrder.Payment.Cents", "Cents", "Cents", "gt")
+
+	// the field must hold the variant of its discriminator
+	err = validate.Struct(variantOrder{Type: "bank", Payment: variantCard{Number: "4111111111111111", Cents: 100}})
+	NotEqual(t, err, nil)
+	Equal(t, len(err.(ValidationErrors)), 1)
+	AssertError(t, err, "variantOrder.Payment", "variantOrder.Payment", "Payment", "Payment", "variant")
+	Equal(t, err.(ValidationErrors)[0].Param(), "bank")
+
+	// maps are decoded into the variant
+	err = validate.Struct(variantOrder{Type: "bank", Payment: map[string]interface{}{"iban": "", "cents": 100}})
+	NotEqual(t, err, nil)
+	Equal(t, len(err.(ValidationErrors)), 1)
+	AssertError(t, err, "variantOrder.Payment.IBAN", "variantOrder.Payment.IBAN", "IBAN", "IBAN", "required")
+
+	Equal(t, validate.Struct(variantOrder{Type: "bank", Payment: map[string]interface{}{"iban": "DE89370400440532013000", "cents": 100}}), nil)
+
+	err = validate.Struct(variantOrder{Type: "card", Payment: map[string]interface{}{"number": 4111}})
+	NotEqual(t, err, nil)
+	AssertError(t, err, "variantOrder.Payment", "variantOrder.Payment", "Payment", "Payment", "variant")
+
+	// fields without a variant, or nil, are validated as usual
+	Equal(t, validate.Struct(variantOrder{Type: "cash", Payment: "on delivery"}), nil)
+
+	err = validate.Struct(variantOrder{Type: "card"})
+	NotEqual(t, err, nil)
+	AssertError(t, err, "variantOrder.Payment", "variantOrder.Payment", "Payment", "Payment", "required")
+
+	// the json names are used in errors with a tag name func
+	named := New()
+	named.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		return strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+	})
+	Equal(t, named.RegisterVariants(variantOrder{}, "Payment", "Type", map[string]interface{}{"card": variantCard{}}), nil)
+
+	err = named.Struct(variantOrder{Type: "card", Payment: map[string]interface{}{"number": "4111111111111111"}})
+	NotEqual(t, err, nil)
+	AssertError(t, err, "variantOrder.Payment.cents", "variantOrder.Payment.Cents", "cents", "Cents", "gt")
+
+	// no variants remove them
+	Equal(t, validate.RegisterVariants(variantOrder{}, "Payment", "Type", nil), nil)
+	Equal(t, validate.Struct(variantOrder{Type: "bank", Payment: variantCard{Number: "4111111111111111", Cents: 100}}), nil)
+
+	// variants must implement the interface of the field
+	err = validate.RegisterVariants(&variantInvoice{}, "Payment", "Kind", map[string]interface{}{"1": variantCard{}, "2": variantBank{}})
+	Equal(t, err, nil)
+
+	err = validate.Struct(variantInvoice{Kind: 2, Payment: &variantBank{Cents: 100}})
+	NotEqual(t, err, nil)
+	AssertError(t, err, "variantInvoice.Payment.IBAN", "variantInvoice.Payment.IBAN", "IBAN", "IBAN", "required")
+
+	err = validate.RegisterVariants(variantInvoice{}, "Payment", "Kind", map[string]interface{}{"1": variantOrder{}})
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: variant validator.variantOrder of validator.variantInvoice.Payment does not implement validator.variantPayment")
+
+	err = validate.RegisterVariants(variantInvoice{}, "Kind", "Kind", nil)
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: field Kind of validator.variantInvoice is not an interface")
+
+	err = validate.RegisterVariants(variantInvoice{}, "Payment", "Type", nil)
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: validator.variantInvoice has no exported field Type")
+
+	err = validate.RegisterVariants(variantInvoice{}, "Payment", "Kind", map[string]interface{}{"1": 1})
+	NotEqual(t, err, nil)
+	Equal(t, err.Error(), "validator: int is not a struct type")
+
+	err = validate.RegisterVariants(1, "Payment", "Kind", nil)
+	NotEqual(t, err, nil)
+}
@@ -0,0 +1,167 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+const variantTag = "variant"
+
+// fieldVariants are the variants of an interface field, registered with
+// RegisterVariants.
+type fieldVariants struct {
+	discriminator int // index of the discriminator field
+	types         map[string]reflect.Type
+}
+
+// RegisterVariants registers the variants of field, an interface field of the
+// struct type t, keyed by the value of discriminator, a field of t formatted
+// with fmt.Sprint. t may be a struct, a pointer to one or its reflect.Type and
+// variants map discriminator values to structs, pointers to structs or their
+// reflect.Type, eg.
+//
+//	validate.RegisterVariants(Order{}, "Payment", "Type", map[string]interface{}{
+//		"card": CardPayment{},
+//		"bank": BankPayment{},
+//	})
+//
+// The field is validated as the variant of the value of its discriminator, and
+// errors of the variant's fields are reported under the namespace of the field,
+// eg. Order.Payment.Number. The field must hold the variant, or a pointer to
+// it, or a map with string keys, such as a decoded JSON object, which is
+// decoded into the variant with encoding/json. Otherwise the field fails the
+// variant tag with the value of the discriminator as its param.
+//
+// Fields whose discriminator has no variant, or which are nil, are validated
+// as usual; use oneof on the discriminator to restrict its values. Registering
+// variants for a field again replaces its previous variants, and no variants
+// remove them.
+//
+// It returns an error if t is not a struct, field is not an exported interface
+// field, discriminator is not an exported field or a variant is not a struct
+// implementing the interface of field.
+//
+// NOTE:
+// - this method is safe to call concurrently with validation, it discards all cached structs
+func (v *Validate) RegisterVariants(t interface{}, field string, discriminator string, variants map[string]interface{}) error {
+
+	typ, err := structType(t)
+	if err != nil {
+		return err
+	}
+
+	fld, ok := typ.FieldByName(field)
+	if !ok || len(fld.Index) > 1 || len(fld.PkgPath) > 0 {
+		return fmt.Errorf("validator: %s has no exported field %s", typ, field)
+	}
+
+	if fld.Type.Kind() != reflect.Interface {
+		return fmt.Errorf("validator: field %s of %s is not an interface", field, typ)
+	}
+
+	disc, ok := typ.FieldByName(discriminator)
+	if !ok || len(disc.Index) > 1 || len(disc.PkgPath) > 0 {
+		return fmt.Errorf("validator: %s has no exported field %s", typ, discriminator)
+	}
+
+	fv := &fieldVariants{
+		discriminator: disc.Index[0],
+		types:         make(map[string]reflect.Type, len(variants)),
+	}
+
+	for key, variant := range variants {
+		vt, err := structType(variant)
+		if err != nil {
+			return err
+		}
+		if !vt.Implements(fld.Type) && !reflect.PtrTo(vt).Implements(fld.Type) {
+			return fmt.Errorf("validator: variant %s of %s.%s does not implement %s", vt, typ, field, fld.Type)
+		}
+		fv.types[key] = vt
+	}
+
+	v.register(func(r *registry) {
+		r.resetStructCaches()
+
+		copied := make(map[string]*fieldVariants, len(r.variants[typ])+1)
+		for name, fv := range r.variants[typ] {
+			copied[name] = fv
+		}
+
+		if len(fv.types) == 0 {
+			delete(copied, field)
+		} else {
+			copied[field] = fv
+		}
+		r.variants[typ] = copied
+	})
+	return nil
+}
+
+// traverseVariant validates current, an interface field of parent having
+// variants, as the variant selected by its discriminator.
+func (v *validate) traverseVariant(ctx context.Context, parent reflect.Value, current reflect.Value, ns []byte, structNs []byte, cf *cField) {
+
+	disc, kind, _ := v.extractTypeInternal(parent.Field(cf.variants.discriminator), false)
+
+	var typ reflect.Type
+	var param string
+
+	switch kind {
+	case reflect.Ptr, reflect.Interface, reflect.Invalid:
+	default:
+		param = fmt.Sprint(disc.Interface())
+		typ = cf.variants.types[param]
+	}
+
+	val, kind, _ := v.extractTypeInternal(current, false)
+
+	switch {
+	case typ == nil || kind == reflect.Ptr || kind == reflect.Interface || kind == reflect.Invalid:
+		// no variant or nil, left to the tags of the field
+		v.traverseField(ctx, parent, current, ns, structNs, cf, cf.cTags)
+		return
+
+	case val.Type() == typ:
+		v.traverseField(ctx, parent, current, ns, structNs, cf, cf.cTags)
+		return
+
+	case kind == reflect.Map && val.Type().Key().Kind() == reflect.String:
+		decoded := reflect.New(typ)
+		b, err := json.Marshal(val.Interface())
+		if err == nil {
+			err = json.Unmarshal(b, decoded.Interface())
+		}
+		if err == nil {
+			v.traverseField(ctx, parent, decoded, ns, structNs, cf, cf.cTags)
+			return
+		}
+	}
+
+	v.str1 = string(append(ns, cf.altName...))
+	if v.v.hasTagNameFunc {
+		v.str2 = string(append(structNs, cf.name...))
+	} else {
+		v.str2 = v.str1
+	}
+
+	v.errs = append(v.errs,
+		&fieldError{
+			v:              v.v,
+			tag:            variantTag,
+			actualTag:      variantTag,
+			ns:             v.str1,
+			structNs:       v.str2,
+			fieldLen:       uint8(len(cf.altName)),
+			structfieldLen: uint8(len(cf.name)),
+			msgs:           cf.msgs,
+			parent:         structTypeOf(parent),
+			value:          val.Interface(),
+			param:          param,
+			kind:           kind,
+			typ:            val.Type(),
+		},
+	)
+}